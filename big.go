@@ -0,0 +1,338 @@
+package durafmt
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BigDuration a duration beyond the ±292 years of time.Duration, up to
+// ±292 billion years. Seconds and Nanos have the same sign and |Nanos| < 1e9.
+type BigDuration struct {
+	Seconds int64
+	Nanos   int32
+}
+
+// NewBigDuration returns d as a BigDuration.
+func NewBigDuration(d time.Duration) BigDuration {
+	return BigDuration{int64(d / time.Second), int32(d % time.Second)}
+}
+
+// normalize returns b with Seconds and Nanos of the same sign and |Nanos| < 1e9.
+func (b BigDuration) normalize() BigDuration {
+	seconds, nanos := b.Seconds+int64(b.Nanos/1e9), b.Nanos%1e9
+	switch {
+	case seconds > 0 && nanos < 0:
+		seconds, nanos = seconds-1, nanos+1e9
+	case seconds < 0 && nanos > 0:
+		seconds, nanos = seconds+1, nanos-1e9
+	}
+	return BigDuration{seconds, nanos}
+}
+
+// Duration returns b as a time.Duration, false if it is out of range.
+func (b BigDuration) Duration() (time.Duration, bool) {
+	const maxSeconds = int64(1<<63-1) / int64(time.Second)
+	if b.Seconds > maxSeconds || b.Seconds < -maxSeconds-1 {
+		return 0, false
+	}
+	d := time.Duration(b.Seconds) * time.Second
+	if (b.Nanos > 0 && d > 1<<63-1-time.Duration(b.Nanos)) || (b.Nanos < 0 && d < -1<<63-time.Duration(b.Nanos)) {
+		return 0, false
+	}
+	return d + time.Duration(b.Nanos), true
+}
+
+// LongUnits the optional units longer than a year.
+type LongUnits struct {
+	Millennium, Century, Decade Unit
+}
+
+// DefaultLongUnits english LongUnits.
+var DefaultLongUnits = LongUnits{
+	Unit{"millennium", "millennia"},
+	Unit{"century", "centuries"},
+	Unit{"decade", "decades"},
+}
+
+// longUnitYears the lengths in years of the LongUnits, from millennium, and
+// longLimitUnits their names accepted by WithLimitToUnit.
+var (
+	longUnitYears  = []int64{1000, 100, 10}
+	longLimitUnits = []string{"millennia", "centuries", "decades"}
+)
+
+// bigSystem the units of a BigDurafmt, the long units, if any, then a UnitSystem.
+type bigSystem struct {
+	units   UnitSystem // The long units have no Short name and Length.
+	lengths []*big.Int // Lengths of the units in nanoseconds.
+	long    int        // Number of long units.
+}
+
+// BigDurafmt holds a parsed BigDuration.
+// The options of New apply, see ParseBig. WithLimitToUnit also accepts
+// "decades", "centuries" and "millennia", which outputs the units longer than
+// a year with DefaultLongUnits if LongUnits is not set.
+type BigDurafmt struct {
+	duration  BigDuration
+	input     string     // Used as reference.
+	longUnits *LongUnits // Non-nil to output units longer than a year.
+	config
+}
+
+// ParseBig creates a new *BigDurafmt struct with the output format set by opts.
+// Seconds and Nanos of b may have different signs, they are added up.
+func ParseBig(b BigDuration, opts ...Option) *BigDurafmt {
+	return &BigDurafmt{duration: b.normalize(), config: newConfig(opts)}
+}
+
+// ParseBigString creates a new *BigDurafmt struct from a string in Go duration
+// syntax, like ParseString, without the int64 range limit, e.g. "300000000h".
+// returns an error if input is invalid.
+func ParseBigString(input string, opts ...Option) (*BigDurafmt, error) {
+	b, err := parseBigDuration(input)
+	if err != nil {
+		return nil, err
+	}
+	d := ParseBig(b, opts...)
+	d.input = input
+	return d, nil
+}
+
+var bigUnitNanos = map[string]int64{
+	"ns": 1,
+	"us": 1e3,
+	"µs": 1e3,
+	"μs": 1e3,
+	"ms": 1e6,
+	"s":  1e9,
+	"m":  60e9,
+	"h":  3600e9,
+}
+
+func parseBigDuration(input string) (BigDuration, error) {
+	invalid := errors.New("durafmt: invalid duration " + strconv.Quote(input))
+	s := input
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "0" {
+		return BigDuration{}, errors.New("durafmt: missing unit in duration " + input)
+	}
+	if s == "" {
+		return BigDuration{}, invalid
+	}
+
+	total := new(big.Int)
+	for s != "" {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		whole := s[:i]
+		frac := ""
+		if i < len(s) && s[i] == '.' {
+			j := i + 1
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			frac = s[i+1 : j]
+			i = j
+		}
+		if whole == "" && frac == "" {
+			return BigDuration{}, invalid
+		}
+		s = s[i:]
+
+		j := 0
+		for j < len(s) && s[j] != '.' && (s[j] < '0' || s[j] > '9') {
+			j++
+		}
+		unit, ok := bigUnitNanos[s[:j]]
+		if !ok {
+			return BigDuration{}, errors.New("durafmt: unknown unit " + strconv.Quote(s[:j]) + " in duration " + strconv.Quote(input))
+		}
+		s = s[j:]
+
+		v := new(big.Int)
+		if whole != "" {
+			v.SetString(whole, 10)
+			v.Mul(v, big.NewInt(unit))
+		}
+		if frac != "" {
+			f, _ := new(big.Int).SetString(frac, 10)
+			f.Mul(f, big.NewInt(unit))
+			f.Quo(f, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac))), nil))
+			v.Add(v, f)
+		}
+		total.Add(total, v)
+	}
+
+	seconds, nanos := new(big.Int).QuoRem(total, big.NewInt(1e9), new(big.Int))
+	if !seconds.IsInt64() {
+		return BigDuration{}, errors.New("durafmt: invalid duration " + strconv.Quote(input) + ": overflow")
+	}
+	b := BigDuration{seconds.Int64(), int32(nanos.Int64())}
+	if neg {
+		b.Seconds, b.Nanos = -b.Seconds, -b.Nanos
+	}
+	return b, nil
+}
+
+// LongUnits sets the output format, outputing decades, centuries and millennia with lu.
+// Units longer than a year are only output with the default units, WithUnits
+// and WithLocale, without WithUnitSystem and WithDayLength.
+func (b *BigDurafmt) LongUnits(lu LongUnits) *BigDurafmt {
+	b.longUnits = &lu
+	return b
+}
+
+// BigDuration returns the parsed duration.
+func (b *BigDurafmt) BigDuration() BigDuration {
+	return b.duration
+}
+
+// String parses b *BigDurafmt into a human readable duration with default units,
+// or the units and layout set by the options of ParseBig.
+func (b *BigDurafmt) String() string {
+	switch b.layout {
+	case LayoutInternational:
+		return b.InternationalString()
+	case LayoutClock:
+		return b.ClockString()
+	}
+	return b.format(b.system(b.unitSystem(), true), longName)
+}
+
+// Format parses b *BigDurafmt into a human readable duration with units.
+func (b *BigDurafmt) Format(units Units) string {
+	return b.format(b.system(b.standardSystem(units), true), longName)
+}
+
+// InternationalString parses b *BigDurafmt into a human readable duration with international units.
+// Units longer than a year are output in years.
+func (b *BigDurafmt) InternationalString() string {
+	return b.format(b.system(b.unitSystem(), false), shortName)
+}
+
+// ClockString parses b *BigDurafmt into a clock like duration, "H:MM:SS",
+// like Durafmt.ClockString.
+func (b *BigDurafmt) ClockString() string {
+	m, neg := b.magnitude()
+	seconds := new(big.Int).Quo(m, big.NewInt(int64(time.Second)))
+	hours, rem := new(big.Int).QuoRem(seconds, big.NewInt(3600), new(big.Int))
+	r := rem.Int64()
+	clock := fmt.Sprintf("%s:%02d:%02d", hours, r/60, r%60)
+	return b.sign.apply(clock, neg && m.Sign() > 0, !neg && m.Sign() > 0)
+}
+
+// system returns the units of s with their lengths, preceded by the units
+// longer than a year if long, and set by LongUnits or WithLimitToUnit.
+func (b *BigDurafmt) system(s UnitSystem, long bool) bigSystem {
+	var bs bigSystem
+	lu := b.longUnits
+	for _, name := range longLimitUnits {
+		if lu == nil && b.limitUnit == name {
+			lu = &DefaultLongUnits
+		}
+	}
+	if long && lu != nil && b.config.system == nil && b.day == 0 {
+		year := big.NewInt(int64(unitLengths[0]))
+		for i, u := range []Unit{lu.Millennium, lu.Century, lu.Decade} {
+			bs.units = append(bs.units, SystemUnit{Unit: u})
+			bs.lengths = append(bs.lengths, new(big.Int).Mul(year, big.NewInt(longUnitYears[i])))
+		}
+		bs.long = len(bs.units)
+	}
+	for _, u := range s {
+		bs.units = append(bs.units, u)
+		bs.lengths = append(bs.lengths, big.NewInt(int64(u.Length)))
+	}
+	return bs
+}
+
+// index returns the index of the unit named name, a long unit or a unit of
+// the UnitSystem, see `UnitSystem.index`. The names of the long units accepted
+// by WithLimitToUnit are the first unit if there are no long units.
+func (s bigSystem) index(name string) int {
+	for i, u := range s.units[:s.long] {
+		if name == u.Singular || name == u.Plural {
+			return i
+		}
+	}
+	for j, n := range longLimitUnits {
+		if name == n {
+			if s.long == 0 {
+				return 0
+			}
+			return j
+		}
+	}
+	return s.long + s.units[s.long:].index(name)
+}
+
+// magnitude returns the absolute value of b in nanoseconds, rounded if
+// requested, and whether b is negative.
+func (b *BigDurafmt) magnitude() (*big.Int, bool) {
+	m := big.NewInt(b.duration.Seconds)
+	m.Mul(m, big.NewInt(int64(time.Second)))
+	m.Add(m, big.NewInt(int64(b.duration.Nanos)))
+	neg := m.Sign() < 0
+	m.Abs(m)
+	if b.round > 0 {
+		// Halfway values round away from zero, like time.Duration.Round.
+		unit := big.NewInt(int64(b.round))
+		q, r := new(big.Int).QuoRem(m, unit, new(big.Int))
+		if r.Lsh(r, 1).Cmp(unit) >= 0 {
+			q.Add(q, big.NewInt(1))
+		}
+		m = q.Mul(q, unit)
+	}
+	return m, neg
+}
+
+// format breaks b down into the units of s, named by name, and outputs it
+// like Durafmt.
+func (b *BigDurafmt) format(s bigSystem, name func(u SystemUnit, one bool) string) string {
+	m, neg := b.magnitude()
+	// Zero durations parsed from a string keep their sign, "-0h" is "-0 hours".
+	zero := b.duration == BigDuration{}
+	bd := breakdown{
+		units:    s.units,
+		input:    b.input,
+		zero:     m.Sign() == 0,
+		negative: (neg && m.Sign() > 0) || (zero && strings.HasPrefix(b.input, "-")),
+		positive: !neg && m.Sign() > 0,
+	}
+
+	first := 0
+	if b.limitUnit != "" {
+		first = s.index(b.limitUnit)
+	}
+
+	if b.decimals != nil {
+		// The limit unit, or else the largest unit not longer than b.
+		i := first
+		for b.limitUnit == "" && i < len(s.units)-1 && m.Cmp(s.lengths[i]) < 0 {
+			i++
+		}
+		v := new(big.Float).Quo(new(big.Float).SetInt(m), new(big.Float).SetInt(s.lengths[i]))
+		bd.units, bd.values = s.units[i:i+1], []string{v.Text('f', *b.decimals)}
+		return b.output(bd, name)
+	}
+
+	remaining := new(big.Int).Set(m)
+	for i := range s.units {
+		v := new(big.Int)
+		if i >= first {
+			v.QuoRem(remaining, s.lengths[i], remaining)
+		}
+		bd.values = append(bd.values, v.String())
+	}
+	return b.output(bd, name)
+}
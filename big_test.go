@@ -0,0 +1,173 @@
+package durafmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestParseBigString for durafmt big duration string conversion.
+func TestParseBigString(t *testing.T) {
+	testStrings := []struct {
+		test     string
+		expected string
+	}{
+		{"1µs", "1 microsecond"},
+		{"1.5s", "1 second 500 milliseconds"},
+		{"354h22m3.24s", "2 weeks 18 hours 22 minutes 3 seconds 240 milliseconds"},
+		{"300000h", "34 years 12 weeks 6 days"},
+		{"3000000h", "342 years 24 weeks 2 days"},
+		{"-3000000h", "-342 years 24 weeks 2 days"},
+		{"87600000000h", "10000000 years"},
+		{"0s", "0 seconds"},
+		{"-0s", "-0 seconds"},
+		{"0h", "0 hours"},
+		{"1ns", "0 microseconds"},
+		{"-1ns", "-0 microseconds"},
+	}
+
+	for _, table := range testStrings {
+		d, err := ParseBigString(table.test)
+		if err != nil {
+			t.Errorf("ParseBigString(%q): %v", table.test, err)
+			continue
+		}
+		if result := d.String(); result != table.expected {
+			t.Errorf("ParseBigString(%q).String() = %q, expected %q", table.test, result, table.expected)
+		}
+	}
+
+	for _, test := range []string{"", "0", "-", "1", "1d", "h", "1.h2", "99999999999999999999999h"} {
+		if _, err := ParseBigString(test); err == nil {
+			t.Errorf("ParseBigString(%q). got nil error, expected an error", test)
+		}
+	}
+}
+
+// TestParseBigString_MatchesParseString for inputs in the time.Duration range.
+func TestParseBigString_MatchesParseString(t *testing.T) {
+	for _, test := range []string{"1µs", "2ms", "8759h", "17519h", "-100s", "6h7m8s9ms", "2562047h47m16.854775807s", "-0s", "0m", "-1ns", "0s"} {
+		d, err := ParseString(test)
+		if err != nil {
+			t.Fatal(err)
+		}
+		duration := d.Duration()
+		expected, intl := d.String(), d.InternationalString()
+		b, err := ParseBigString(test)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != expected || b.InternationalString() != intl {
+			t.Errorf("ParseBigString(%q) = %q, expected %q", test, b.String(), expected)
+		}
+		if got, ok := b.BigDuration().Duration(); !ok || got != duration {
+			t.Errorf("ParseBigString(%q).BigDuration().Duration() = %v, %v, expected %v", test, got, ok, duration)
+		}
+	}
+}
+
+func TestBigDurafmt_LongUnits(t *testing.T) {
+	b := BigDuration{Seconds: 1234*365*24*3600 + 7*24*3600 + 5}
+	tests := []struct {
+		test     *BigDurafmt
+		expected string
+	}{
+		{ParseBig(b), "1234 years 1 week 5 seconds"},
+		{ParseBig(b).LongUnits(DefaultLongUnits), "1 millennium 2 centuries 3 decades 4 years 1 week 5 seconds"},
+		{ParseBig(b, WithLimitFirstN(2)).LongUnits(DefaultLongUnits), "1 millennium 2 centuries"},
+		{ParseBig(b, WithLimitToUnit("decades")).LongUnits(DefaultLongUnits), "123 decades 4 years 1 week 5 seconds"},
+		{ParseBig(b, WithLimitToUnit("decades")), "123 decades 4 years 1 week 5 seconds"},
+		{ParseBig(b, WithLimitToUnit("centuries"), WithLimitFirstN(2)), "12 centuries 3 decades"},
+		{ParseBig(b, WithLimitToUnit("days")), "450417 days 5 seconds"},
+		{ParseBig(b, WithLimitToUnit("centuries"), WithLayout(LayoutInternational)), "1234 y 1 w 5 s"},
+		{ParseBig(BigDuration{-20 * 365 * 24 * 3600, 0}).LongUnits(DefaultLongUnits), "-2 decades"},
+		{ParseBig(BigDuration{math.MaxInt64, 999999999}, WithLimitToUnit("microseconds")), "9223372036854775807999999 microseconds"},
+		{ParseBig(BigDuration{3, 4000000}, WithLimitToUnit("milliseconds")), "3004 milliseconds"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.test.String(); got != tt.expected {
+			t.Errorf("String() = %q, expected %q", got, tt.expected)
+		}
+	}
+
+	units, err := DefaultUnitsCoder.Decode("ano,semana,dia,hora,minuto,segundo,milissegundo,microssegundo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lu := LongUnits{Unit{"milênio", "milênios"}, Unit{"século", "séculos"}, Unit{"década", "décadas"}}
+	if got := ParseBig(b).LongUnits(lu).Format(units); got != "1 milênio 2 séculos 3 décadas 4 anos 1 semana 5 segundos" {
+		t.Errorf("Format() = %q", got)
+	}
+	if got := ParseBig(b).LongUnits(lu).InternationalString(); got != "1234 y 1 w 5 s" {
+		t.Errorf("InternationalString() = %q", got)
+	}
+}
+
+func TestParseBig_Normalize(t *testing.T) {
+	tests := []struct {
+		test     BigDuration
+		expected string
+		big      BigDuration
+	}{
+		{BigDuration{Seconds: 1, Nanos: -5}, "999 milliseconds 999 microseconds", BigDuration{0, 999999995}},
+		{BigDuration{Seconds: -2, Nanos: 500000000}, "-1 second 500 milliseconds", BigDuration{-1, -500000000}},
+		{BigDuration{Seconds: 1, Nanos: 1500000000}, "2 seconds 500 milliseconds", BigDuration{2, 500000000}},
+		{BigDuration{Seconds: 1, Nanos: -1000000000}, "0 seconds", BigDuration{}},
+	}
+	for _, tt := range tests {
+		b := ParseBig(tt.test)
+		if got := b.String(); got != tt.expected {
+			t.Errorf("ParseBig(%+v).String() = %q, expected %q", tt.test, got, tt.expected)
+		}
+		if got := b.BigDuration(); got != tt.big {
+			t.Errorf("ParseBig(%+v).BigDuration() = %+v, expected %+v", tt.test, got, tt.big)
+		}
+	}
+}
+
+func TestParseBig_Options(t *testing.T) {
+	b := BigDuration{Seconds: -(1234*365*24*3600 + 7*24*3600 + 5)}
+	tests := []struct {
+		test     *BigDurafmt
+		expected string
+	}{
+		{ParseBig(b, WithSeparators(" ", ", "), WithLimitFirstN(2)), "-1234 years, 1 week"},
+		{ParseBig(b, WithSign(SignAgo), WithLimitToUnit("weeks")), "64345 weeks 2 days 5 seconds ago"},
+		{ParseBig(b, WithSign(SignParens), WithLimitFirstN(1)), "(1234 years)"},
+		{ParseBig(b, WithLocale("pt")), "-1234 anos 1 semana 5 segundos"},
+		{ParseBig(b, WithLayout(LayoutInternational)), "-1234 y 1 w 5 s"},
+		{ParseBig(BigDuration{Seconds: 3*3600 + 62}, WithLayout(LayoutClock)), "3:01:02"},
+		{ParseBig(b, WithRounding(time.Hour)), "-1234 years 1 week"},
+		{ParseBig(b, WithDecimals(1)), "-1234.0 years"},
+		{ParseBig(b, WithDecimals(2)).LongUnits(DefaultLongUnits), "-1.23 millennia"},
+		{ParseBig(BigDuration{Nanos: -1}, WithSign(SignWord)), "minus 0 microseconds"},
+		{ParseBig(BigDuration{Nanos: -1}, WithRounding(time.Second)), "0 seconds"},
+	}
+	for _, tt := range tests {
+		if got := tt.test.String(); got != tt.expected {
+			t.Errorf("String() = %q, expected %q", got, tt.expected)
+		}
+	}
+}
+
+func TestBigDuration_Duration(t *testing.T) {
+	tests := []struct {
+		test     BigDuration
+		expected time.Duration
+		ok       bool
+	}{
+		{NewBigDuration(time.Duration(math.MaxInt64)), time.Duration(math.MaxInt64), true},
+		{NewBigDuration(time.Duration(math.MinInt64)), time.Duration(math.MinInt64), true},
+		{NewBigDuration(-1500 * time.Millisecond), -1500 * time.Millisecond, true},
+		{BigDuration{9223372036, 854775808}, 0, false},
+		{BigDuration{-9223372036, -854775809}, 0, false},
+		{BigDuration{math.MaxInt64, 0}, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.test.Duration()
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("%+v.Duration() = %v, %v, expected %v, %v", tt.test, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
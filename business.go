@@ -0,0 +1,103 @@
+package durafmt
+
+import "time"
+
+// DefaultWorkingDay default length of a working day of Calendar.
+const DefaultWorkingDay = 8 * time.Hour
+
+// WorkingUnits english units of working time, "1 working day 2 working hours".
+var WorkingUnits, _ = DefaultUnitsCoder.Decode("year,week,working day,working hour,minute,second,millisecond,microsecond")
+
+// WorkingHours a period of working time in a day, from Start to End on the
+// clock, e.g. `WorkingHours{9 * time.Hour, 17 * time.Hour}` from 9:00 to 17:00.
+type WorkingHours struct {
+	Start, End time.Duration
+}
+
+// Calendar a business calendar, to measure elapsed working time.
+type Calendar struct {
+	// Week the working hours of each day of the week, indexed by time.Weekday.
+	Week [7][]WorkingHours
+	// Holidays days without working hours, only their date counts.
+	Holidays []time.Time
+	// Location the time zone of the working hours, UTC if nil.
+	Location *time.Location
+	// DayLength the length of a working day output by ParseBetween, DefaultWorkingDay if 0.
+	DayLength time.Duration
+}
+
+// NewCalendar creates a new *Calendar with working hours from start to end on
+// the days, Monday to Friday if no day is given, e.g.
+// `NewCalendar(9*time.Hour, 17*time.Hour)`.
+func NewCalendar(start, end time.Duration, days ...time.Weekday) *Calendar {
+	if len(days) == 0 {
+		days = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	c := &Calendar{}
+	for _, day := range days {
+		c.Week[day] = []WorkingHours{{start, end}}
+	}
+	return c
+}
+
+// Between returns the working time elapsed from from to to, negative if to is
+// before from. Working hours are wall clock times, so days with a daylight
+// saving time change still have their usual working hours.
+func (c *Calendar) Between(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return -c.Between(to, from)
+	}
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	type date struct {
+		year  int
+		month time.Month
+		day   int
+	}
+	holidays := make(map[date]bool, len(c.Holidays))
+	for _, h := range c.Holidays {
+		y, m, d := h.Date()
+		holidays[date{y, m, d}] = true
+	}
+
+	var total time.Duration
+	y, m, d := from.In(loc).Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(to); day = time.Date(y, m, d+1, 0, 0, 0, 0, loc) {
+		y, m, d = day.Date()
+		if holidays[date{y, m, d}] {
+			continue
+		}
+		for _, hours := range c.Week[day.Weekday()] {
+			// The clock times of the working hours, whatever the length of the day.
+			start := clock(y, m, d, hours.Start, loc)
+			end := clock(y, m, d, hours.End, loc)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+	return total
+}
+
+// clock returns the time at the clock time t of the day y-m-d in loc.
+func clock(y int, m time.Month, d int, t time.Duration, loc *time.Location) time.Time {
+	return time.Date(y, m, d, 0, 0, int(t/time.Second), int(t%time.Second), loc)
+}
+
+// ParseBetween creates a new *Durafmt struct of the working time elapsed from
+// from to to, with WorkingUnits and days of c.DayLength, e.g. "2 working hours".
+func (c *Calendar) ParseBetween(from, to time.Time) *Durafmt {
+	day := c.DayLength
+	if day <= 0 {
+		day = DefaultWorkingDay
+	}
+	return New(c.Between(from, to), WithUnits(WorkingUnits), WithDayLength(day))
+}
@@ -0,0 +1,91 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendar_Between(t *testing.T) {
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2024, time.March, day, hour, min, 0, 0, time.UTC)
+	}
+	weekdays := NewCalendar(9*time.Hour, 18*time.Hour)
+	holiday := NewCalendar(9*time.Hour, 18*time.Hour)
+	holiday.Holidays = []time.Time{time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local)}
+	lunch := &Calendar{}
+	lunch.Week[time.Monday] = []WorkingHours{{9 * time.Hour, 12 * time.Hour}, {13 * time.Hour, 17 * time.Hour}}
+	weekend := NewCalendar(10*time.Hour, 14*time.Hour, time.Saturday, time.Sunday)
+
+	tests := []struct {
+		calendar *Calendar
+		from, to time.Time
+		expected time.Duration
+	}{
+		// Friday 17:00 to Monday 10:00.
+		{weekdays, at(1, 17, 0), at(4, 10, 0), 2 * time.Hour},
+		{weekdays, at(4, 10, 0), at(1, 17, 0), -2 * time.Hour},
+		{weekdays, at(4, 10, 0), at(4, 12, 30), 2*time.Hour + 30*time.Minute},
+		{weekdays, at(4, 7, 0), at(4, 8, 0), 0},
+		{weekdays, at(2, 9, 0), at(3, 23, 0), 0},
+		{weekdays, at(4, 9, 0), at(5, 9, 0), 9 * time.Hour},
+		{weekdays, at(4, 0, 0), at(11, 0, 0), 45 * time.Hour},
+		{weekdays, at(4, 10, 0), at(4, 10, 0), 0},
+		{holiday, at(1, 17, 0), at(5, 10, 0), 2 * time.Hour},
+		{lunch, at(4, 11, 0), at(4, 14, 0), 2 * time.Hour},
+		{lunch, at(4, 0, 0), at(5, 0, 0), 7 * time.Hour},
+		{weekend, at(1, 0, 0), at(4, 0, 0), 8 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := tt.calendar.Between(tt.from, tt.to); got != tt.expected {
+			t.Errorf("Between(%v, %v) = %v, expected %v", tt.from, tt.to, got, tt.expected)
+		}
+	}
+}
+
+func TestCalendar_Between_Location(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*3600)
+	c := NewCalendar(9*time.Hour, 17*time.Hour)
+	c.Location = loc
+	// 13:00 to 23:00 UTC is 8:00 to 18:00 in loc.
+	from := time.Date(2024, time.March, 4, 13, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.March, 4, 23, 0, 0, 0, time.UTC)
+	if got := c.Between(from, to); got != 8*time.Hour {
+		t.Errorf("Between() in %v = %v, expected 8h", loc, got)
+	}
+
+	// Working hours are wall clock times on the day daylight saving time starts.
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	c = NewCalendar(0, 6*time.Hour, time.Sunday)
+	c.Location = ny
+	from = time.Date(2024, time.March, 10, 0, 0, 0, 0, ny)
+	if got := c.Between(from, from.Add(24*time.Hour)); got != 5*time.Hour {
+		t.Errorf("Between() on a daylight saving time day = %v, expected 5h", got)
+	}
+}
+
+func TestCalendar_ParseBetween(t *testing.T) {
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2024, time.March, day, hour, min, 0, 0, time.UTC)
+	}
+	c := NewCalendar(9*time.Hour, 18*time.Hour)
+	tests := []struct {
+		dayLength time.Duration
+		from, to  time.Time
+		expected  string
+	}{
+		{0, at(1, 17, 0), at(4, 10, 0), "2 working hours"},
+		{0, at(4, 9, 0), at(5, 9, 0), "1 working day 1 working hour"},
+		{9 * time.Hour, at(4, 9, 0), at(5, 9, 0), "1 working day"},
+		{0, at(4, 0, 0), at(18, 0, 0), "11 working days 2 working hours"},
+		{0, at(4, 10, 30), at(4, 9, 0), "-1 working hour 30 minutes"},
+	}
+	for _, tt := range tests {
+		c.DayLength = tt.dayLength
+		if got := c.ParseBetween(tt.from, tt.to).String(); got != tt.expected {
+			t.Errorf("ParseBetween(%v, %v) = %q, expected %q", tt.from, tt.to, got, tt.expected)
+		}
+	}
+}
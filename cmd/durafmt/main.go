@@ -0,0 +1,184 @@
+// Command durafmt formats Go durations into a human readable format, and back.
+//
+// Usage:
+//
+//	durafmt [flags] [duration ...]
+//
+// Durations are read from the arguments or, if there are none, line by line
+// from standard input:
+//
+//	$ durafmt 354h22m3.24s
+//	2 weeks 18 hours 22 minutes 3 seconds 240 milliseconds
+//	$ durafmt -n 2 354h22m3.24s
+//	2 weeks 18 hours
+//	$ durafmt -reverse seconds "2 weeks 3 days"
+//	1468800
+//
+// With -filter, standard input is copied to standard output line by line,
+// rewriting every Go duration embedded in the text:
+//
+//	$ tail -f app.log | durafmt -filter -n 2
+//	request served in 1 minute 30 seconds
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hako/durafmt"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// options the command line options.
+type options struct {
+	limitN    int
+	limitUnit string
+	intl      bool
+	units     durafmt.Units
+	reverse   string
+	filter    bool
+	allow     string
+	deny      string
+}
+
+// run runs the command with args, returning the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("durafmt", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: durafmt [flags] [duration ...]")
+		fmt.Fprintln(stderr, "Formats Go durations, read from the arguments or line by line from standard input.")
+		fs.PrintDefaults()
+	}
+	var (
+		opts   options
+		short  = fs.Bool("short", false, "output only the first element, same as -n 1")
+		locale = fs.String("locale", "en", "`tag` of the output locale, one of "+strings.Join(durafmt.Locales(), ", "))
+	)
+	fs.IntVar(&opts.limitN, "n", 0, "output only the first `n` elements, 0 means no limit")
+	fs.StringVar(&opts.limitUnit, "unit", "", "largest `unit` to output, e.g. hours or days")
+	fs.BoolVar(&opts.intl, "intl", false, "output international units, e.g. 2 w 18 h")
+	fs.StringVar(&opts.reverse, "reverse", "", "convert human readable input to `syntax` go, iso or seconds")
+	fs.BoolVar(&opts.filter, "filter", false, "rewrite the Go durations embedded in standard input")
+	fs.StringVar(&opts.allow, "allow", "", "with -filter, rewrite only durations matching `regexp`")
+	fs.StringVar(&opts.deny, "deny", "", "with -filter, leave durations matching `regexp` untouched")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *short {
+		opts.limitN = 1
+	}
+	l, ok := durafmt.LookupLocale(*locale)
+	if !ok {
+		fmt.Fprintf(stderr, "durafmt: unknown locale %s\n", *locale)
+		return 2
+	}
+	opts.units = l.Units
+	switch opts.reverse {
+	case "", "go", "iso", "seconds":
+	default:
+		fmt.Fprintf(stderr, "durafmt: unknown -reverse syntax %s\n", opts.reverse)
+		return 2
+	}
+
+	if opts.filter {
+		return opts.runFilter(stdin, stdout, stderr)
+	}
+
+	status := 0
+	convert := func(input string) {
+		output, err := opts.convert(input)
+		if err != nil {
+			fmt.Fprintln(stderr, "durafmt:", strings.TrimPrefix(err.Error(), "durafmt: "))
+			status = 1
+			return
+		}
+		fmt.Fprintln(stdout, output)
+	}
+
+	if fs.NArg() > 0 {
+		for _, arg := range fs.Args() {
+			convert(arg)
+		}
+		return status
+	}
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			convert(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, "durafmt:", err)
+		return 1
+	}
+	return status
+}
+
+// convert converts a single input according to the options.
+func (opts options) convert(input string) (string, error) {
+	if opts.reverse != "" {
+		d, err := durafmt.ParseHuman(input)
+		if err != nil {
+			return "", err
+		}
+		switch opts.reverse {
+		case "iso":
+			return d.ISO8601String(), nil
+		case "seconds":
+			return strconv.FormatFloat(d.Duration().Seconds(), 'f', -1, 64), nil
+		default:
+			return d.Duration().String(), nil
+		}
+	}
+
+	d, err := durafmt.ParseString(input)
+	if err != nil {
+		return "", err
+	}
+	return opts.format(d), nil
+}
+
+// format formats d according to the options.
+func (opts options) format(d *durafmt.Durafmt) string {
+	d = d.LimitFirstN(opts.limitN).LimitToUnit(opts.limitUnit)
+	if opts.intl {
+		return d.InternationalString()
+	}
+	return d.Format(opts.units)
+}
+
+// runFilter rewrites the durations embedded in stdin, returning the exit status.
+func (opts options) runFilter(stdin io.Reader, stdout, stderr io.Writer) int {
+	fopts := durafmt.FilterOptions{
+		Style: func(d time.Duration) string { return opts.format(durafmt.Parse(d)) },
+	}
+	var err error
+	if opts.allow != "" {
+		if fopts.Allow, err = regexp.Compile(opts.allow); err != nil {
+			fmt.Fprintln(stderr, "durafmt: bad -allow:", err)
+			return 2
+		}
+	}
+	if opts.deny != "" {
+		if fopts.Deny, err = regexp.Compile(opts.deny); err != nil {
+			fmt.Fprintln(stderr, "durafmt: bad -deny:", err)
+			return 2
+		}
+	}
+	if err := durafmt.Filter(stdout, stdin, fopts); err != nil {
+		fmt.Fprintln(stderr, "durafmt:", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args     []string
+		stdin    string
+		expected string
+		status   int
+	}{
+		{[]string{"354h22m3.24s"}, "", "2 weeks 18 hours 22 minutes 3 seconds 240 milliseconds\n", 0},
+		{[]string{"-short", "354h22m3.24s"}, "", "2 weeks\n", 0},
+		{[]string{"-n", "2", "354h22m3.24s", "-100s"}, "", "2 weeks 18 hours\n-1 minute 40 seconds\n", 0},
+		{[]string{"-unit", "days", "354h22m3s"}, "", "14 days 18 hours 22 minutes 3 seconds\n", 0},
+		{[]string{"-intl", "354h22m3s"}, "", "2 w 18 h 22 m 3 s\n", 0},
+		{[]string{"-locale", "pt", "354h22m3s"}, "", "2 semanas 18 horas 22 minutos 3 segundos\n", 0},
+		{[]string{"-n", "1"}, "1h30m\n\n  90s  \n", "1 hour\n1 minute\n", 0},
+		{[]string{"-reverse", "go", "2 weeks 3 days"}, "", "408h0m0s\n", 0},
+		{[]string{"-reverse", "iso", "2 weeks 3 days 1.5 seconds"}, "", "P17DT1.5S\n", 0},
+		{[]string{"-reverse", "seconds"}, "2 weeks 3 days\n1 minute and 30 seconds\n", "1468800\n90\n", 0},
+		{[]string{"-filter", "-n", "2"}, "took 1m30.5s\nv1.2s 2h45m0s, 5sec\n", "took 1 minute 30 seconds\nv1.2s 2 hours 45 minutes, 5sec\n", 0},
+		{[]string{"-filter", "-intl", "-deny", "ms$"}, "3h10m 250ms 5s", "3 h 10 m 250ms 5 s", 0},
+		{[]string{"-filter", "-allow", "("}, "", "", 2},
+		{[]string{"1h", "1d", "2m"}, "", "1 hour\n2 minutes\n", 1},
+		{[]string{"-reverse", "go", "3 fortnights"}, "", "", 1},
+		{[]string{"-reverse", "yaml", "1h"}, "", "", 2},
+		{[]string{"-locale", "xx", "1h"}, "", "", 2},
+		{[]string{"-nope"}, "", "", 2},
+	}
+
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+		if status != tt.status {
+			t.Errorf("run(%q) = %d, expected %d, stderr %q", tt.args, status, tt.status, stderr.String())
+		}
+		if stdout.String() != tt.expected {
+			t.Errorf("run(%q) output %q, expected %q", tt.args, stdout.String(), tt.expected)
+		}
+		if status != 0 && stderr.Len() == 0 {
+			t.Errorf("run(%q) failed without writing to stderr", tt.args)
+		}
+	}
+}
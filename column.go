@@ -0,0 +1,78 @@
+package durafmt
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// FormatColumn formats durations for a column of a table, like
+// `NewFormatter(opts...).Column(durations)`.
+func FormatColumn(durations []time.Duration, opts ...Option) []string {
+	return NewFormatter(opts...).Column(durations)
+}
+
+// Column formats durations for a column of a table, so they are easy to scan:
+// all the durations are output in the same unit, with one decimal unless set
+// by WithDecimals, e.g. "  2.1 hours", "  0.8 hours" and " 12.0 hours".
+// The unit is the limit unit of WithLimitToUnit if set, otherwise the largest
+// unit not longer than the median of the durations.
+//
+// The values are padded with spaces to the same width in cells, like Fit
+// measures it, the numbers aligned to the right and the units to the left,
+// for monospaced output and text/tabwriter.
+func (f Formatter) Column(durations []time.Duration) []string {
+	if len(durations) == 0 {
+		return nil
+	}
+	c := f.config
+	if c.decimals == nil {
+		one := 1
+		c.decimals = &one
+	}
+	if c.limitUnit == "" {
+		s := c.unitSystem()
+		c.limitUnit = s[c.commonUnit(s, medianDuration(durations))].Plural
+	}
+	if c.layout == LayoutClock {
+		c.layout = LayoutLong
+	}
+
+	cells := make([]string, len(durations))
+	ends := make([]int, len(durations)) // Width up to the end of the number.
+	maxEnd, maxWidth := 0, 0
+	for i, duration := range durations {
+		d := Durafmt{duration: duration, config: c}
+		cells[i] = d.String()
+		_, number := d.decimal(d.unitSystem())
+		end := strings.Index(cells[i], number) + len(number)
+		ends[i] = displayWidth(cells[i][:end])
+		if ends[i] > maxEnd {
+			maxEnd = ends[i]
+		}
+	}
+	for i := range cells {
+		cells[i] = strings.Repeat(" ", maxEnd-ends[i]) + cells[i]
+		if w := displayWidth(cells[i]); w > maxWidth {
+			maxWidth = w
+		}
+	}
+	for i := range cells {
+		cells[i] += strings.Repeat(" ", maxWidth-displayWidth(cells[i]))
+	}
+	return cells
+}
+
+// medianDuration returns the median magnitude of durations.
+func medianDuration(durations []time.Duration) time.Duration {
+	m := make([]uint64, len(durations))
+	for i, d := range durations {
+		m[i] = magnitude(d)
+	}
+	sort.Slice(m, func(i, j int) bool { return m[i] < m[j] })
+	median := m[len(m)/2]
+	if median > 1<<63-1 {
+		median = 1<<63 - 1
+	}
+	return time.Duration(median)
+}
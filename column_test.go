@@ -0,0 +1,74 @@
+package durafmt
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+	"text/tabwriter"
+	"time"
+)
+
+func TestFormatColumn(t *testing.T) {
+	tests := []struct {
+		durations []time.Duration
+		opts      []Option
+		expected  []string
+	}{
+		{nil, nil, nil},
+		{
+			[]time.Duration{2*time.Hour + 3*time.Minute, 45 * time.Second, 12 * time.Minute},
+			nil,
+			[]string{"123.0 minutes", "  0.8 minutes", " 12.0 minutes"},
+		},
+		{
+			[]time.Duration{2*time.Hour + 3*time.Minute, 45 * time.Minute, 12 * time.Hour},
+			[]Option{WithLayout(LayoutInternational)},
+			[]string{" 2.0 h", " 0.8 h", "12.0 h"},
+		},
+		{
+			[]time.Duration{time.Hour, 10 * time.Hour},
+			[]Option{WithDecimals(0)},
+			[]string{" 1 hour ", "10 hours"},
+		},
+		{
+			[]time.Duration{-90 * time.Second, 30 * time.Second},
+			[]Option{WithLimitToUnit("seconds"), WithDecimals(0)},
+			[]string{"-90 seconds", " 30 seconds"},
+		},
+		{
+			[]time.Duration{-90 * time.Second, 30 * time.Second},
+			[]Option{WithSign(SignAgo)},
+			[]string{"1.5 minutes ago", "0.5 minutes    "},
+		},
+		{
+			[]time.Duration{90 * time.Second, 1500 * time.Millisecond},
+			[]Option{WithLocale("pt"), WithLayout(LayoutClock)},
+			[]string{"1.5 minutos", "0.0 minutos"},
+		},
+	}
+
+	for _, tt := range tests {
+		got := FormatColumn(tt.durations, tt.opts...)
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("FormatColumn(%v) = %q, expected %q", tt.durations, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatColumn_Tabwriter(t *testing.T) {
+	jobs := []string{"build", "test", "deploy"}
+	durations := []time.Duration{2*time.Hour + 3*time.Minute, 45 * time.Second, 12 * time.Minute}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 1, ' ', 0)
+	for i, cell := range FormatColumn(durations, WithLayout(LayoutInternational)) {
+		fmt.Fprintf(w, "%s\t%s\t|\n", jobs[i], cell)
+	}
+	w.Flush()
+
+	expected := "build  123.0 m |\ntest     0.8 m |\ndeploy  12.0 m |\n"
+	if buf.String() != expected {
+		t.Errorf("tabwriter output = %q, expected %q", buf.String(), expected)
+	}
+}
@@ -0,0 +1,31 @@
+package durafmt
+
+import (
+	"context"
+	"time"
+)
+
+// formatterKey the context key of a Formatter.
+type formatterKey struct{}
+
+// WithFormatter returns a copy of ctx carrying f.
+func WithFormatter(ctx context.Context, f Formatter) context.Context {
+	return context.WithValue(ctx, formatterKey{}, f)
+}
+
+// FromContext returns the Formatter carried by ctx, or the zero Formatter,
+// which formats with the package defaults, if there is none.
+func FromContext(ctx context.Context) Formatter {
+	f, _ := ctx.Value(formatterKey{}).(Formatter)
+	return f
+}
+
+// StringCtx formats d with the Formatter carried by ctx.
+func StringCtx(ctx context.Context, d time.Duration) string {
+	return FromContext(ctx).Format(d)
+}
+
+// ParseCtx creates a new *Durafmt struct with the output format of the Formatter carried by ctx.
+func ParseCtx(ctx context.Context, d time.Duration) *Durafmt {
+	return FromContext(ctx).Parse(d)
+}
@@ -0,0 +1,36 @@
+package durafmt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	if got := StringCtx(ctx, 90*time.Minute); got != "1 hour 30 minutes" {
+		t.Errorf("StringCtx() without Formatter = %q", got)
+	}
+
+	ctx = WithFormatter(ctx, NewFormatter(WithLocale("fr"), WithLimitFirstN(1)))
+	if got := StringCtx(ctx, 90*time.Minute); got != "1 heure" {
+		t.Errorf("StringCtx() = %q", got)
+	}
+	if got := ParseCtx(ctx, 90*time.Minute).LimitFirstN(2).String(); got != "1 heure 30 minutes" {
+		t.Errorf("ParseCtx().LimitFirstN(2) = %q", got)
+	}
+
+	// Derived contexts carry the Formatter, and can override it.
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if got := StringCtx(child, 2*time.Hour); got != "2 heures" {
+		t.Errorf("StringCtx() of derived context = %q", got)
+	}
+	override := WithFormatter(child, FromContext(child).With(WithLayout(LayoutInternational)))
+	if got := StringCtx(override, 2*time.Hour); got != "2 h" {
+		t.Errorf("StringCtx() of overridden context = %q", got)
+	}
+	if got := StringCtx(ctx, 2*time.Hour); got != "2 heures" {
+		t.Errorf("StringCtx() of parent context = %q", got)
+	}
+}
@@ -0,0 +1,84 @@
+package durafmt
+
+import (
+	"context"
+	"time"
+)
+
+// Countdown returns a channel receiving the time remaining until deadline,
+// formatted with style, LongStyle if nil, e.g. "4 minutes 59 seconds",
+// "4 minutes 58 seconds", ..., "0 seconds".
+//
+// The remaining time is rounded up to a multiple of interval, one second if
+// interval <= 0, and a value is sent only when its text changes, so a style
+// like ShortStyle does not repeat "4 minutes" every second. The channel is
+// closed after the deadline text is sent or when ctx is done.
+// Values are not dropped, the countdown waits for each to be received.
+func Countdown(ctx context.Context, deadline time.Time, interval time.Duration, style Style) <-chan string {
+	return countdown(ctx, deadline, interval, style, realTime)
+}
+
+// timeSource the current time and timers, replaced in tests.
+type timeSource struct {
+	now func() time.Time
+	// timer returns a channel receiving the time after d, and a function stopping the timer.
+	timer func(d time.Duration) (<-chan time.Time, func() bool)
+}
+
+var realTime = timeSource{
+	now: time.Now,
+	timer: func(d time.Duration) (<-chan time.Time, func() bool) {
+		t := time.NewTimer(d)
+		return t.C, t.Stop
+	},
+}
+
+func countdown(ctx context.Context, deadline time.Time, interval time.Duration, style Style, ts timeSource) <-chan string {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	if style == nil {
+		style = LongStyle
+	}
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		last, sent := "", false
+		for {
+			remaining := deadline.Sub(ts.now())
+			if remaining < 0 {
+				remaining = 0
+			}
+			wait := remaining % interval
+			if wait == 0 {
+				wait = interval
+			}
+			shown := remaining
+			if remaining%interval != 0 {
+				shown += interval - remaining%interval
+			}
+
+			if text := style(shown); !sent || text != last {
+				select {
+				case ch <- text:
+				case <-ctx.Done():
+					return
+				}
+				last, sent = text, true
+			}
+			if remaining == 0 {
+				return
+			}
+
+			// Wake up when the shown time changes, at the next multiple of interval.
+			c, stop := ts.timer(wait)
+			select {
+			case <-c:
+			case <-ctx.Done():
+				stop()
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,119 @@
+package durafmt
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeTimer a timer of a fakeTimeSource.
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+// fakeTimeSource the time and timers of a fakeClock, fired by run.
+type fakeTimeSource struct {
+	clock  *fakeClock
+	timers chan fakeTimer
+}
+
+func newFakeTimeSource() *fakeTimeSource {
+	return &fakeTimeSource{newFakeClock(), make(chan fakeTimer)}
+}
+
+func (f *fakeTimeSource) timeSource() timeSource {
+	return timeSource{
+		now: f.clock.Now,
+		timer: func(d time.Duration) (<-chan time.Time, func() bool) {
+			c := make(chan time.Time, 1)
+			f.timers <- fakeTimer{f.clock.Now().Add(d), c}
+			return c, func() bool { return true }
+		},
+	}
+}
+
+// run receives the texts of ch, firing each timer late after advancing the clock to it.
+func (f *fakeTimeSource) run(ch <-chan string, late time.Duration) []string {
+	var texts []string
+	for {
+		select {
+		case text, ok := <-ch:
+			if !ok {
+				return texts
+			}
+			texts = append(texts, text)
+		case timer := <-f.timers:
+			f.clock.Advance(timer.at.Sub(f.clock.Now()) + late)
+			timer.c <- f.clock.Now()
+		}
+	}
+}
+
+func TestCountdown(t *testing.T) {
+	f := newFakeTimeSource()
+	ch := countdown(context.Background(), f.clock.Now().Add(55*time.Millisecond), 10*time.Millisecond, func(d time.Duration) string {
+		return d.String()
+	}, f.timeSource())
+	texts := f.run(ch, 0)
+	expected := []string{"60ms", "50ms", "40ms", "30ms", "20ms", "10ms", "0s"}
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Countdown() = %q, expected %q", texts, expected)
+	}
+}
+
+func TestCountdown_Late(t *testing.T) {
+	// Timers firing late skip the texts of the times passed.
+	f := newFakeTimeSource()
+	ch := countdown(context.Background(), f.clock.Now().Add(55*time.Millisecond), 10*time.Millisecond, func(d time.Duration) string {
+		return d.String()
+	}, f.timeSource())
+	texts := f.run(ch, 12*time.Millisecond)
+	expected := []string{"60ms", "40ms", "20ms", "0s"}
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Countdown() = %q, expected %q", texts, expected)
+	}
+}
+
+func TestCountdown_Precision(t *testing.T) {
+	// A style showing 40ms units, which only changes every fourth interval.
+	style := func(d time.Duration) string {
+		return strconv.Itoa(int((d + 40*time.Millisecond - 1) / (40 * time.Millisecond)))
+	}
+	f := newFakeTimeSource()
+	ch := countdown(context.Background(), f.clock.Now().Add(100*time.Millisecond), 10*time.Millisecond, style, f.timeSource())
+	texts := f.run(ch, 0)
+	expected := []string{"3", "2", "1", "0"}
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Countdown() = %q, expected %q", texts, expected)
+	}
+}
+
+func TestCountdown_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Countdown(ctx, time.Now().Add(time.Hour), time.Minute, nil)
+	if text := <-ch; text != "1 hour" {
+		t.Errorf("Countdown() first text = %q, expected %q", text, "1 hour")
+	}
+	cancel()
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Errorf("Countdown() sent a value after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Countdown() not closed after cancel")
+	}
+}
+
+func TestCountdown_PastDeadline(t *testing.T) {
+	var texts []string
+	for text := range Countdown(context.Background(), time.Now().Add(-time.Minute), 0, nil) {
+		texts = append(texts, text)
+	}
+	if len(texts) != 1 || texts[0] != "0 seconds" {
+		t.Errorf("Countdown() = %q, expected [\"0 seconds\"]", texts)
+	}
+}
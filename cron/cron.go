@@ -0,0 +1,320 @@
+// Package cron parses cron expressions, describes their schedules in words
+// and computes their next runs, with the time until each run formatted by durafmt.
+//
+//	s, err := cron.Parse("*/15 9-17 * * 1-5")
+//	fmt.Println(s.Describe()) // every 15 minutes from 09:00 to 17:45 on Monday to Friday
+//	for _, run := range s.Runs(time.Now(), 3) {
+//		fmt.Println(run.Time, "in", run.Until)
+//	}
+//
+// Expressions have 5 fields, minute, hour, day of month, month and day of week,
+// or 6 fields, with a leading second field. Fields are `*`, values, ranges
+// `1-5`, steps `*/15` or `1-30/2` and lists of those, `1,15`. Months and days
+// of the week may be given by their english names, `JAN` or `mon`, Sunday is
+// 0 or 7, and `?` is `*` in the day fields. The macros `@yearly`, `@annually`,
+// `@monthly`, `@weekly`, `@daily`, `@midnight` and `@hourly` are accepted.
+// As in standard cron, if both the day of month and the day of week are
+// restricted, a day matching either runs.
+package cron
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hako/durafmt"
+)
+
+// field the range and names of a cron field.
+type field struct {
+	name     string
+	min, max int
+	names    []string // Names of the values from min, if any.
+}
+
+var (
+	secondField = field{name: "second", max: 59}
+	minuteField = field{name: "minute", max: 59}
+	hourField   = field{name: "hour", max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: []string{
+		"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	dowField = field{name: "day of week", max: 7, names: []string{
+		"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule a parsed cron expression.
+type Schedule struct {
+	expr                         string
+	seconds                      bool // The expression has a second field.
+	second, minute, hour         uint64
+	dom, month, dow              uint64
+	domRestricted, dowRestricted bool
+}
+
+// Parse parses a cron expression of 5 or 6 fields, or a macro.
+// returns an error if expr is invalid.
+func Parse(expr string) (*Schedule, error) {
+	s := &Schedule{expr: expr}
+	spec := strings.TrimSpace(expr)
+	if m, ok := macros[strings.ToLower(spec)]; ok {
+		spec = m
+	}
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+		s.seconds = true
+	default:
+		return nil, errors.New("cron: expected 5 or 6 fields in " + strconv.Quote(expr))
+	}
+
+	var err error
+	parse := func(f field, text string) uint64 {
+		if err != nil {
+			return 0
+		}
+		var bits uint64
+		bits, err = parseField(f, text)
+		if err != nil {
+			err = errors.New("cron: invalid " + f.name + " " + strconv.Quote(text) + " in " + strconv.Quote(expr) + ": " + err.Error())
+		}
+		return bits
+	}
+	s.second = parse(secondField, fields[0])
+	s.minute = parse(minuteField, fields[1])
+	s.hour = parse(hourField, fields[2])
+	s.dom = parse(domField, fields[3])
+	s.month = parse(monthField, fields[4])
+	s.dow = parse(dowField, fields[5])
+	if err != nil {
+		return nil, err
+	}
+	// Sunday is 0 or 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domRestricted = !isStar(fields[3])
+	s.dowRestricted = !isStar(fields[5])
+	return s, nil
+}
+
+// MustParse is like Parse but panics if expr is invalid.
+func MustParse(expr string) *Schedule {
+	s, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func isStar(text string) bool {
+	return text == "*" || text == "?"
+}
+
+// parseField returns the set of values of text, a bit per value.
+func parseField(f field, text string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(text, ",") {
+		rng, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, errors.New("bad step " + strconv.Quote(part[i+1:]))
+			}
+			rng, step = part[:i], n
+		}
+
+		var lo, hi int
+		switch {
+		case isStar(rng):
+			lo, hi = f.min, f.max
+		case strings.IndexByte(rng, '-') > 0:
+			i := strings.IndexByte(rng, '-')
+			var err error
+			if lo, err = f.value(rng[:i]); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(rng[i+1:]); err != nil {
+				return 0, err
+			}
+			if hi < lo {
+				return 0, errors.New("bad range " + strconv.Quote(rng))
+			}
+		default:
+			var err error
+			if lo, err = f.value(rng); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if step > 1 {
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// value parses a value of f, a number or a name.
+func (f field) value(text string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(text, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.New("bad value " + strconv.Quote(text))
+	}
+	return v, nil
+}
+
+// String returns the cron expression of s.
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// has reports whether v is in the set bits.
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
+
+// dayMatches reports whether s runs on the day of t.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom, dow := has(s.dom, t.Day()), has(s.dow, int(t.Weekday()))
+	if s.domRestricted && s.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// maxYears how far Next looks for a run, for schedules like February 30th.
+const maxYears = 5
+
+// Next returns the first run of s after t, in the location of t, or the zero
+// time if s does not run in the next five years.
+// As in standard cron, runs at clock times skipped when daylight saving time
+// starts run once, when it starts.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Add(time.Second).Truncate(time.Second)
+	if !s.seconds {
+		t = t.Add(59 * time.Second).Truncate(time.Minute)
+	}
+	end := t.AddDate(maxYears, 0, 0)
+
+	for t.Before(end) {
+		y, mo, d := t.Date()
+		h, mi, sec := t.Clock()
+		var w time.Time
+		switch {
+		case !has(s.month, int(mo)):
+			w = time.Date(y, mo+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			w = time.Date(y, mo, d+1, 0, 0, 0, 0, time.UTC)
+		case !has(s.hour, h):
+			w = time.Date(y, mo, d, h+1, 0, 0, 0, time.UTC)
+		case !has(s.minute, mi):
+			w = time.Date(y, mo, d, h, mi+1, 0, 0, time.UTC)
+		case !has(s.second, sec):
+			w = time.Date(y, mo, d, h, mi, sec+1, 0, time.UTC)
+		default:
+			return t
+		}
+		next, ok := inLocation(w, loc)
+		if !ok && s.runsInGap(w, loc) {
+			return next
+		}
+		// Clock times repeated when daylight saving time ends may go back.
+		if !next.After(t) {
+			next = t.Add(time.Second)
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+// inLocation returns the time at the clock time of w in loc, and whether the
+// clock time exists in loc. Clock times skipped when daylight saving time
+// starts do not, their time is after the skipped clock times.
+func inLocation(w time.Time, loc *time.Location) (time.Time, bool) {
+	t := time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, loc)
+	tw := clockTime(t)
+	// time.Date may return a time before the skipped clock times.
+	if tw.Before(w) {
+		t = t.Add(w.Sub(tw))
+	}
+	return t, tw.Equal(w)
+}
+
+// clockTime returns the clock time of t, in UTC.
+func clockTime(t time.Time) time.Time {
+	y, mo, d := t.Date()
+	h, mi, sec := t.Clock()
+	return time.Date(y, mo, d, h, mi, sec, 0, time.UTC)
+}
+
+// runsInGap reports whether s runs at a clock time from w skipped in loc,
+// w being the first skipped clock time s may run at.
+func (s *Schedule) runsInGap(w time.Time, loc *time.Location) bool {
+	step := time.Minute
+	if s.seconds {
+		step = time.Second
+	}
+	for ; ; w = w.Add(step) {
+		if _, ok := inLocation(w, loc); ok {
+			return false
+		}
+		if has(s.month, int(w.Month())) && s.dayMatches(w) && has(s.hour, w.Hour()) &&
+			has(s.minute, w.Minute()) && has(s.second, w.Second()) {
+			return true
+		}
+	}
+}
+
+// NextN returns the next n runs of s after t, fewer if s does not run in the
+// five years after the last one.
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	var runs []time.Time
+	for len(runs) < n {
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+		runs = append(runs, t)
+	}
+	return runs
+}
+
+// Run a run of a Schedule.
+type Run struct {
+	Time time.Time
+	// Until the time from now until Time, rounded to the second.
+	Until *durafmt.Durafmt
+}
+
+// Runs returns the next n runs of s after now, with the time until each
+// formatted with opts, e.g. `durafmt.WithLimitFirstN(2)` for "3 hours 12 minutes".
+func (s *Schedule) Runs(now time.Time, n int, opts ...durafmt.Option) []Run {
+	opts = append([]durafmt.Option{durafmt.WithRounding(time.Second)}, opts...)
+	var runs []Run
+	for _, t := range s.NextN(now, n) {
+		runs = append(runs, Run{t, durafmt.New(t.Sub(now), opts...)})
+	}
+	return runs
+}
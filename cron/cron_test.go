@@ -0,0 +1,153 @@
+package cron
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hako/durafmt"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"5-1 * * * *",
+		"-1 * * * *",
+		"a * * * *",
+		"* * * FOO *",
+		"1,,2 * * * *",
+		"@reboot",
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) expected an error", expr)
+		}
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// Friday 1 March 2024.
+	now := time.Date(2024, time.March, 1, 10, 7, 30, 500, time.UTC)
+	at := func(month time.Month, day, hour, min, sec int) time.Time {
+		return time.Date(2024, month, day, hour, min, sec, 0, time.UTC)
+	}
+	tests := []struct {
+		expr     string
+		expected []time.Time
+	}{
+		{"* * * * *", []time.Time{at(3, 1, 10, 8, 0), at(3, 1, 10, 9, 0)}},
+		{"*/15 * * * *", []time.Time{at(3, 1, 10, 15, 0), at(3, 1, 10, 30, 0), at(3, 1, 10, 45, 0)}},
+		{"*/20 * * * * *", []time.Time{at(3, 1, 10, 7, 40), at(3, 1, 10, 8, 0)}},
+		{"0 * * * *", []time.Time{at(3, 1, 11, 0, 0), at(3, 1, 12, 0, 0)}},
+		{"@hourly", []time.Time{at(3, 1, 11, 0, 0)}},
+		{"@daily", []time.Time{at(3, 2, 0, 0, 0), at(3, 3, 0, 0, 0)}},
+		{"30 9 * * 1-5", []time.Time{at(3, 4, 9, 30, 0), at(3, 5, 9, 30, 0)}},
+		{"30 9 * * MON-FRI", []time.Time{at(3, 4, 9, 30, 0)}},
+		{"0 0 * * 7", []time.Time{at(3, 3, 0, 0, 0), at(3, 10, 0, 0, 0)}},
+		{"0 0 * * sun", []time.Time{at(3, 3, 0, 0, 0)}},
+		{"0 12 1 * *", []time.Time{at(3, 1, 12, 0, 0), at(4, 1, 12, 0, 0)}},
+		{"0 0 13 * 5", []time.Time{at(3, 8, 0, 0, 0), at(3, 13, 0, 0, 0), at(3, 15, 0, 0, 0)}},
+		{"0 0 13 * ?", []time.Time{at(3, 13, 0, 0, 0), at(4, 13, 0, 0, 0)}},
+		{"0 0 29 2 *", []time.Time{time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)}},
+		{"0 0 1 jan,jul *", []time.Time{at(7, 1, 0, 0, 0), time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+		{"5/20 10 * * *", []time.Time{at(3, 1, 10, 25, 0), at(3, 1, 10, 45, 0), at(3, 2, 10, 5, 0)}},
+		{"0 0 30 2 *", nil},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.expr)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.expr, err)
+			continue
+		}
+		if got := s.NextN(now, len(tt.expected)+1)[:len(tt.expected)]; !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Parse(%q).NextN() = %v, expected %v", tt.expr, got, tt.expected)
+		}
+	}
+}
+
+func TestSchedule_Next_Location(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 2:30 does not exist on the day daylight saving time starts, it runs at 3:00.
+	s := MustParse("30 2 * * *")
+	now := time.Date(2026, time.March, 7, 12, 0, 0, 0, ny)
+	expected := []time.Time{
+		time.Date(2026, time.March, 8, 3, 0, 0, 0, ny),
+		time.Date(2026, time.March, 9, 2, 30, 0, 0, ny),
+	}
+	if got := s.NextN(now, 2); !reflect.DeepEqual(got, expected) {
+		t.Errorf("NextN() across daylight saving time = %v, expected %v", got, expected)
+	}
+
+	// The skipped runs from 2:00 to 2:45 run once, with the 3:00 run.
+	s = MustParse("*/15 * * * *")
+	now = time.Date(2026, time.March, 8, 1, 40, 0, 0, ny)
+	expected = []time.Time{
+		time.Date(2026, time.March, 8, 1, 45, 0, 0, ny),
+		time.Date(2026, time.March, 8, 3, 0, 0, 0, ny),
+		time.Date(2026, time.March, 8, 3, 15, 0, 0, ny),
+	}
+	if got := s.NextN(now, 3); !reflect.DeepEqual(got, expected) {
+		t.Errorf("NextN() across daylight saving time = %v, expected %v", got, expected)
+	}
+
+	// Clock times skipped without a run are not run.
+	s = MustParse("0 4 * * *")
+	now = time.Date(2026, time.March, 7, 12, 0, 0, 0, ny)
+	if got, expected := s.Next(now), time.Date(2026, time.March, 8, 4, 0, 0, 0, ny); !got.Equal(expected) {
+		t.Errorf("Next() across daylight saving time = %v, expected %v", got, expected)
+	}
+
+	// Every run is after the previous one when daylight saving time ends.
+	s = MustParse("*/20 * * * *")
+	now = time.Date(2024, time.November, 3, 0, 50, 0, 0, ny)
+	runs := s.NextN(now, 9)
+	for i := 1; i < len(runs); i++ {
+		if !runs[i].After(runs[i-1]) {
+			t.Errorf("run %d = %v, not after %v", i, runs[i], runs[i-1])
+		}
+	}
+	if len(runs) != 9 {
+		t.Errorf("NextN() = %d runs, expected 9", len(runs))
+	}
+}
+
+func TestSchedule_Runs(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 7, 30, 500, time.UTC)
+	runs := MustParse("0 */6 * * *").Runs(now, 2, durafmt.WithLimitFirstN(2))
+	expected := []string{"1 hour 52 minutes", "7 hours 52 minutes"}
+	if len(runs) != len(expected) {
+		t.Fatalf("Runs() = %d runs, expected %d", len(runs), len(expected))
+	}
+	for i, run := range runs {
+		if got := run.Until.String(); got != expected[i] {
+			t.Errorf("Runs()[%d].Until = %q, expected %q", i, got, expected[i])
+		}
+	}
+	if got := MustParse("* * * * *").Runs(now, 1)[0].Until.String(); got != "30 seconds" {
+		t.Errorf("Runs() rounded = %q", got)
+	}
+	if got := MustParse("0 0 12 * *").Runs(now, 1, durafmt.WithLocale("pt"), durafmt.WithLimitFirstN(1))[0].Until.String(); got != "1 semana" {
+		t.Errorf("Runs() with locale = %q", got)
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse() of an invalid expression did not panic")
+		}
+	}()
+	MustParse("nope")
+}
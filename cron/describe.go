@@ -0,0 +1,272 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hako/durafmt"
+)
+
+// Phrases the words of schedule descriptions of a language, in fmt format
+// with %s verbs. Intervals and rates are described by the durafmt locale of
+// the same tag, see durafmt.Rates.
+type Phrases struct {
+	// At the times of day, "at %s".
+	At string
+	// Between the first and last times of day of a repeated run, "from %s to %s".
+	Between string
+	// Minutes the minutes past the hour of a run repeated every hour or less,
+	// "at minute %s". Between is used if empty.
+	Minutes string
+	// Weekdays the days of the week, "on %s".
+	Weekdays string
+	// Days the days of the month, "on day %s of the month".
+	Days string
+	// Months the months, "in %s".
+	Months string
+	// Or the days of the month or the days of the week, "%s or %s".
+	Or string
+	// Through a range of days of the week, "%s to %s".
+	Through string
+	// And the separator of the last two items of a list, " and ".
+	And string
+	// WeekdayNames the names of the days of the week, from Sunday.
+	WeekdayNames [7]string
+	// MonthNames the names of the months, from January.
+	MonthNames [12]string
+}
+
+// DefaultPhrases english Phrases.
+var DefaultPhrases = Phrases{
+	At:           "at %s",
+	Between:      "from %s to %s",
+	Minutes:      "at minute %s",
+	Weekdays:     "on %s",
+	Days:         "on day %s of the month",
+	Months:       "in %s",
+	Or:           "%s or %s",
+	Through:      "%s to %s",
+	And:          " and ",
+	WeekdayNames: [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	MonthNames: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+}
+
+var (
+	phrasesMu sync.RWMutex
+	phrases   = map[string]Phrases{"en": DefaultPhrases}
+)
+
+// normalizeTag lower cases tag and uses `"-"` as subtag separator, like durafmt.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(tag), "_", "-", -1))
+}
+
+// RegisterPhrases registers p for the language tag, replacing any phrases
+// with the same tag. Tags are matched like durafmt.RegisterLocale tags.
+func RegisterPhrases(tag string, p Phrases) {
+	phrasesMu.Lock()
+	phrases[normalizeTag(tag)] = p
+	phrasesMu.Unlock()
+}
+
+// lookupPhrases returns the phrases of tag, or of its primary language, or
+// DefaultPhrases, and the tag they are registered with.
+func lookupPhrases(tag string) (Phrases, string) {
+	tag = normalizeTag(tag)
+	phrasesMu.RLock()
+	defer phrasesMu.RUnlock()
+	if p, ok := phrases[tag]; ok {
+		return p, tag
+	}
+	if i := strings.IndexByte(tag, '-'); i > 0 {
+		if p, ok := phrases[tag[:i]]; ok {
+			return p, tag[:i]
+		}
+	}
+	return DefaultPhrases, "en"
+}
+
+// maxListedTimes most times of day listed by Describe, more are described as a rate.
+const maxListedTimes = 6
+
+// Describe describes s in english, e.g. "every 15 minutes", "daily at 09:30"
+// or "at 00:00 on day 1 of the month".
+func (s *Schedule) Describe() string {
+	return s.DescribeIn("en")
+}
+
+// DescribeIn describes s in the language of tag, with the phrases registered
+// with RegisterPhrases and the units, intervals and rates of the durafmt
+// locale of tag. Tags without phrases are described in english, even if
+// durafmt has a locale for them.
+func (s *Schedule) DescribeIn(tag string) string {
+	p, tag := lookupPhrases(tag)
+	f := durafmt.NewFormatter(durafmt.WithLocale(durafmt.MatchLocale(tag, "en")))
+
+	times := s.times()
+	everyDay := !s.domRestricted && !s.dowRestricted && s.month == monthField.all()
+
+	var parts []string
+	if period, ok := evenlySpaced(times); ok {
+		parts = append(parts, f.Every(time.Duration(period)*time.Second))
+		if len(times)*period != 24*3600 || times[0] != 0 {
+			parts = append(parts, s.offset(p, times, period))
+		}
+	} else if len(times) > maxListedTimes {
+		parts = append(parts, f.Rate(float64(len(times)), 24*time.Hour))
+	} else {
+		if everyDay {
+			parts = append(parts, f.Every(24*time.Hour))
+		}
+		clocks := make([]string, len(times))
+		for i, t := range times {
+			clocks[i] = s.clock(t)
+		}
+		parts = append(parts, fmt.Sprintf(p.At, p.list(clocks)))
+	}
+
+	var days string
+	if s.domRestricted {
+		var doms []string
+		for v := domField.min; v <= domField.max; v++ {
+			if has(s.dom, v) {
+				doms = append(doms, strconv.Itoa(v))
+			}
+		}
+		days = fmt.Sprintf(p.Days, p.list(doms))
+	}
+	if s.dowRestricted {
+		weekdays := fmt.Sprintf(p.Weekdays, p.weekdays(s.dow))
+		if days != "" {
+			days = fmt.Sprintf(p.Or, days, weekdays)
+		} else {
+			days = weekdays
+		}
+	}
+	if days != "" {
+		parts = append(parts, days)
+	}
+	if s.month != monthField.all() {
+		var months []string
+		for v := monthField.min; v <= monthField.max; v++ {
+			if has(s.month, v) {
+				months = append(months, p.MonthNames[v-1])
+			}
+		}
+		parts = append(parts, fmt.Sprintf(p.Months, p.list(months)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// all returns the set of all the values of f.
+func (f field) all() uint64 {
+	var bits uint64
+	for v := f.min; v <= f.max; v++ {
+		bits |= 1 << uint(v)
+	}
+	return bits
+}
+
+// times returns the sorted times of day s runs at, in seconds.
+func (s *Schedule) times() []int {
+	var times []int
+	for h := 0; h <= hourField.max; h++ {
+		for m := 0; m <= minuteField.max && has(s.hour, h); m++ {
+			for sec := 0; sec <= secondField.max && has(s.minute, m); sec++ {
+				if has(s.second, sec) {
+					times = append(times, (h*60+m)*60+sec)
+				}
+			}
+		}
+	}
+	sort.Ints(times)
+	return times
+}
+
+// evenlySpaced returns the period of times, in seconds, if they are evenly
+// spaced, three times or more, or two times spanning the whole day.
+func evenlySpaced(times []int) (int, bool) {
+	if len(times) < 2 {
+		return 0, false
+	}
+	period := times[1] - times[0]
+	for i := 2; i < len(times); i++ {
+		if times[i]-times[i-1] != period {
+			return 0, false
+		}
+	}
+	return period, len(times) > 2 || 2*period == 24*3600
+}
+
+// offset describes when the evenly spaced times, period seconds apart, are:
+// the times of a run over the whole day starting after midnight, "at 06:00
+// and 18:00" or "at minute 30", or else the first and last times.
+func (s *Schedule) offset(p Phrases, times []int, period int) string {
+	if len(times)*period == 24*3600 {
+		if len(times) <= maxListedTimes {
+			clocks := make([]string, len(times))
+			for i, t := range times {
+				clocks[i] = s.clock(t)
+			}
+			return fmt.Sprintf(p.At, p.list(clocks))
+		}
+		if p.Minutes != "" && 3600%period == 0 && period%60 == 0 && times[0]%60 == 0 {
+			var minutes []string
+			for _, t := range times {
+				if t < 3600 {
+					minutes = append(minutes, strconv.Itoa(t/60))
+				}
+			}
+			return fmt.Sprintf(p.Minutes, p.list(minutes))
+		}
+	}
+	return fmt.Sprintf(p.Between, s.clock(times[0]), s.clock(times[len(times)-1]))
+}
+
+// clock formats the time of day t, in seconds, "09:30", with seconds if s has a second field.
+func (s *Schedule) clock(t int) string {
+	if s.seconds && s.second != 1 {
+		return fmt.Sprintf("%02d:%02d:%02d", t/3600, t/60%60, t%60)
+	}
+	return fmt.Sprintf("%02d:%02d", t/3600, t/60%60)
+}
+
+// list joins items, "a, b and c".
+func (p Phrases) list(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + p.And + items[len(items)-1]
+}
+
+// weekdays lists the days of the week of dow, from Monday, with ranges of
+// three days or more joined by Through, "Monday to Friday".
+func (p Phrases) weekdays(dow uint64) string {
+	var items []string
+	for i := 0; i < 7; {
+		if !has(dow, (i+1)%7) {
+			i++
+			continue
+		}
+		j := i
+		for j+1 < 7 && has(dow, (j+2)%7) {
+			j++
+		}
+		first, last := p.WeekdayNames[(i+1)%7], p.WeekdayNames[(j+1)%7]
+		switch j - i {
+		case 0:
+			items = append(items, first)
+		case 1:
+			items = append(items, first, last)
+		default:
+			items = append(items, fmt.Sprintf(p.Through, first, last))
+		}
+		i = j + 1
+	}
+	return p.list(items)
+}
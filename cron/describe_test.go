@@ -0,0 +1,83 @@
+package cron
+
+import "testing"
+
+func TestSchedule_Describe(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"* * * * *", "every minute"},
+		{"*/15 * * * *", "every 15 minutes"},
+		{"*/30 * * * * *", "every 30 seconds"},
+		{"0 0,12 * * *", "every 12 hours"},
+		{"0 * * * *", "hourly"},
+		{"30 * * * *", "hourly at minute 30"},
+		{"10-59/15 * * * *", "every 15 minutes at minute 10, 25, 40 and 55"},
+		{"0 6,18 * * *", "every 12 hours at 06:00 and 18:00"},
+		{"5 */2 * * *", "every 2 hours from 00:05 to 22:05"},
+		{"15,45 * * * * *", "every 30 seconds from 00:00:15 to 23:59:45"},
+		{"@hourly", "hourly"},
+		{"0 */2 * * *", "every 2 hours"},
+		{"0 0 * * *", "daily at 00:00"},
+		{"30 9 * * *", "daily at 09:30"},
+		{"15 30 9 * * *", "daily at 09:30:15"},
+		{"0 9,12,18 * * *", "daily at 09:00, 12:00 and 18:00"},
+		{"0 9,12 * * *", "daily at 09:00 and 12:00"},
+		{"30 9 * * 1-5", "at 09:30 on Monday to Friday"},
+		{"30 9 * * 1,3,5", "at 09:30 on Monday, Wednesday and Friday"},
+		{"30 9 * * 6,0", "at 09:30 on Saturday and Sunday"},
+		{"*/15 9-17 * * 1-5", "every 15 minutes from 09:00 to 17:45 on Monday to Friday"},
+		{"0 0 1 * *", "at 00:00 on day 1 of the month"},
+		{"0 0 1,15 * *", "at 00:00 on day 1 and 15 of the month"},
+		{"0 0 13 * 5", "at 00:00 on day 13 of the month or on Friday"},
+		{"0 12 * 1,7 *", "at 12:00 in January and July"},
+		{"@yearly", "at 00:00 on day 1 of the month in January"},
+		{"0,1,2,3,5,8,13 * * * *", "168 per day"},
+	}
+	for _, tt := range tests {
+		if got := MustParse(tt.expr).Describe(); got != tt.expected {
+			t.Errorf("Parse(%q).Describe() = %q, expected %q", tt.expr, got, tt.expected)
+		}
+	}
+}
+
+func TestSchedule_DescribeIn(t *testing.T) {
+	pt := Phrases{
+		At:           "às %s",
+		Between:      "das %s às %s",
+		Weekdays:     "de %s",
+		Days:         "no dia %s do mês",
+		Months:       "em %s",
+		Or:           "%s ou %s",
+		Through:      "%s a %s",
+		And:          " e ",
+		WeekdayNames: [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		MonthNames: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	}
+	RegisterPhrases("pt", pt)
+	defer func() {
+		phrasesMu.Lock()
+		delete(phrases, "pt")
+		phrasesMu.Unlock()
+	}()
+
+	tests := []struct {
+		expr, tag, expected string
+	}{
+		{"*/15 * * * *", "pt", "a cada 15 minutos"},
+		{"0 0 * * *", "pt-BR", "diariamente às 00:00"},
+		{"*/15 9-17 * * 1-5", "pt_BR", "a cada 15 minutos das 09:00 às 17:45 de segunda a sexta"},
+		{"0 12 * 1,7 *", "pt", "às 12:00 em janeiro e julho"},
+		// A durafmt locale without phrases is described in english.
+		{"*/15 * * * *", "de", "every 15 minutes"},
+		{"30 9 * * *", "de", "daily at 09:30"},
+		{"*/15 * * * *", "xx", "every 15 minutes"},
+	}
+	for _, tt := range tests {
+		if got := MustParse(tt.expr).DescribeIn(tt.tag); got != tt.expected {
+			t.Errorf("Parse(%q).DescribeIn(%q) = %q, expected %q", tt.expr, tt.tag, got, tt.expected)
+		}
+	}
+}
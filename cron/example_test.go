@@ -0,0 +1,31 @@
+package cron_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hako/durafmt"
+	"github.com/hako/durafmt/cron"
+)
+
+func ExampleSchedule_Describe() {
+	for _, expr := range []string{"*/15 * * * *", "0 * * * *", "30 9 * * 1-5", "*/15 9-17 * * 1-5"} {
+		fmt.Println(cron.MustParse(expr).Describe())
+	}
+	// Output:
+	// every 15 minutes
+	// hourly
+	// at 09:30 on Monday to Friday
+	// every 15 minutes from 09:00 to 17:45 on Monday to Friday
+}
+
+func ExampleSchedule_Runs() {
+	now := time.Date(2024, time.March, 1, 10, 48, 0, 0, time.UTC)
+	for _, run := range cron.MustParse("0 */6 * * *").Runs(now, 3, durafmt.WithLimitFirstN(2)) {
+		fmt.Println(run.Time.Format("Mon 15:04"), "in", run.Until)
+	}
+	// Output:
+	// Fri 12:00 in 1 hour 12 minutes
+	// Fri 18:00 in 7 hours 12 minutes
+	// Sat 00:00 in 13 hours 12 minutes
+}
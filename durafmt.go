@@ -4,7 +4,6 @@ package durafmt
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -17,13 +16,14 @@ var (
 
 // Durafmt holds the parsed duration and the original input duration.
 type Durafmt struct {
-	duration  time.Duration
-	input     string // Used as reference.
-	limitN    int    // Non-zero to limit only first N elements to output.
-	limitUnit string // Non-empty to limit max unit
+	duration time.Duration
+	input    string // Used as reference.
+	config
 }
 
 // LimitToUnit sets the output format, you will not have unit bigger than the UNIT specified. UNIT = "" means no restriction.
+// UNIT is a name of a unit of the output, or one of "years", "weeks", "days", "hours", "minutes",
+// "seconds", "milliseconds" and "microseconds", limiting to the largest unit not longer than it.
 func (d *Durafmt) LimitToUnit(unit string) *Durafmt {
 	d.limitUnit = unit
 	return d
@@ -42,289 +42,295 @@ func (d *Durafmt) Duration() time.Duration {
 // Parse creates a new *Durafmt struct, returns error if input is invalid.
 func Parse(dinput time.Duration) *Durafmt {
 	input := dinput.String()
-	return &Durafmt{dinput, input, 0, ""}
+	return &Durafmt{duration: dinput, input: input}
 }
 
 // ParseShort creates a new *Durafmt struct, short form, returns error if input is invalid.
 // It's shortcut for `Parse(dur).LimitFirstN(1)`
 func ParseShort(dinput time.Duration) *Durafmt {
 	input := dinput.String()
-	return &Durafmt{dinput, input, 1, ""}
+	return &Durafmt{duration: dinput, input: input, config: config{limitN: 1}}
 }
 
 // ParseString creates a new *Durafmt struct from a string.
 // returns an error if input is invalid.
 func ParseString(input string) (*Durafmt, error) {
-	if input == "0" || input == "-0" {
+	// time.ParseDuration accepts a unitless zero, with or without sign.
+	if input == "0" || input == "-0" || input == "+0" {
 		return nil, errors.New("durafmt: missing unit in duration " + input)
 	}
 	duration, err := time.ParseDuration(input)
 	if err != nil {
 		return nil, err
 	}
-	return &Durafmt{duration, input, 0, ""}, nil
+	return &Durafmt{duration: duration, input: input}, nil
 }
 
 // ParseStringShort creates a new *Durafmt struct from a string, short form
 // returns an error if input is invalid.
 // It's shortcut for `ParseString(durStr)` and then calling `LimitFirstN(1)`
 func ParseStringShort(input string) (*Durafmt, error) {
-	if input == "0" || input == "-0" {
-		return nil, errors.New("durafmt: missing unit in duration " + input)
-	}
-	duration, err := time.ParseDuration(input)
+	d, err := ParseString(input)
 	if err != nil {
 		return nil, err
 	}
-	return &Durafmt{duration, input, 1, ""}, nil
+	return d.LimitFirstN(1), nil
 }
 
-// String parses d *Durafmt into a human readable duration with default units.
+// String parses d *Durafmt into a human readable duration with default units,
+// or the units and layout set by the options of New.
 func (d *Durafmt) String() string {
-	return d.Format(units)
+	switch d.layout {
+	case LayoutInternational:
+		return d.InternationalString()
+	case LayoutClock:
+		return d.ClockString()
+	}
+	return d.FormatSystem(d.unitSystem())
 }
 
 // Format parses d *Durafmt into a human readable duration with units.
 func (d *Durafmt) Format(units Units) string {
-	var duration string
+	return d.FormatSystem(d.standardSystem(units))
+}
 
-	// Check for minus durations.
-	if string(d.input[0]) == "-" {
-		duration += "-"
-		d.duration = -d.duration
-	}
+// FormatSystem parses d *Durafmt into a human readable duration with the units of s.
+func (d *Durafmt) FormatSystem(s UnitSystem) string {
+	return d.format(s, longName)
+}
 
-	var microseconds int64
-	var milliseconds int64
-	var seconds int64
-	var minutes int64
-	var hours int64
-	var days int64
-	var weeks int64
-	var years int64
-	var shouldConvert = false
-
-	remainingSecondsToConvert := int64(d.duration / time.Microsecond)
-
-	// Convert duration.
-	if d.limitUnit == "" {
-		shouldConvert = true
-	}
+// InternationalString parses d *Durafmt into a human readable duration with international units.
+func (d *Durafmt) InternationalString() string {
+	return d.format(d.unitSystem(), shortName)
+}
 
-	if d.limitUnit == "years" || shouldConvert {
-		years = remainingSecondsToConvert / (365 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= years * 365 * 24 * 3600 * 1000000
-		shouldConvert = true
+// longName returns the singular or plural name of u.
+func longName(u SystemUnit, one bool) string {
+	if one {
+		return u.Singular
 	}
+	return u.Plural
+}
 
-	if d.limitUnit == "weeks" || shouldConvert {
-		weeks = remainingSecondsToConvert / (7 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= weeks * 7 * 24 * 3600 * 1000000
-		shouldConvert = true
-	}
+// shortName returns the international abbreviation of u.
+func shortName(u SystemUnit, one bool) string {
+	return u.Short
+}
 
-	if d.limitUnit == "days" || shouldConvert {
-		days = remainingSecondsToConvert / (24 * 3600 * 1000000)
-		remainingSecondsToConvert -= days * 24 * 3600 * 1000000
-		shouldConvert = true
+// value returns the duration to format, rounded if requested.
+func (d *Durafmt) value() time.Duration {
+	if d.round > 0 {
+		return d.duration.Round(d.round)
 	}
+	return d.duration
+}
 
-	if d.limitUnit == "hours" || shouldConvert {
-		hours = remainingSecondsToConvert / (3600 * 1000000)
-		remainingSecondsToConvert -= hours * 3600 * 1000000
-		shouldConvert = true
-	}
+// limitUnits the units accepted by LimitToUnit, in the order of `Units.Units()`.
+var limitUnits = []string{"years", "weeks", "days", "hours", "minutes", "seconds", "milliseconds", "microseconds"}
 
-	if d.limitUnit == "minutes" || shouldConvert {
-		minutes = remainingSecondsToConvert / (60 * 1000000)
-		remainingSecondsToConvert -= minutes * 60 * 1000000
-		shouldConvert = true
+// magnitude returns the absolute value of d, which unlike -d does not
+// overflow for math.MinInt64.
+func magnitude(d time.Duration) uint64 {
+	if d < 0 {
+		return -uint64(d)
 	}
+	return uint64(d)
+}
 
-	if d.limitUnit == "seconds" || shouldConvert {
-		seconds = remainingSecondsToConvert / 1000000
-		remainingSecondsToConvert -= seconds * 1000000
-		shouldConvert = true
+// standardSystem returns the UnitSystem of units, with days c.day long if set.
+func (c *config) standardSystem(units Units) UnitSystem {
+	s := units.System()
+	if c.day > 0 {
+		// No weeks and years of working days.
+		s = s[2:]
+		s[0].Length = c.day
 	}
+	return s
+}
 
-	if d.limitUnit == "milliseconds" || shouldConvert {
-		milliseconds = remainingSecondsToConvert / 1000
-		remainingSecondsToConvert -= milliseconds * 1000
+// unitSystem returns the UnitSystem output by String.
+func (c *config) unitSystem() UnitSystem {
+	if c.system != nil {
+		return c.system
 	}
-
-	microseconds = remainingSecondsToConvert
-
-	// Create a map of the converted duration time.
-	durationMap := []int64{
-		microseconds,
-		milliseconds,
-		seconds,
-		minutes,
-		hours,
-		days,
-		weeks,
-		years,
+	if c.units != nil {
+		return c.standardSystem(*c.units)
+	}
+	if c.day == 0 {
+		return DefaultUnitSystem
 	}
+	return c.standardSystem(units)
+}
 
-	// Construct duration string.
-	for i, u := range units.Units() {
-		v := durationMap[7-i]
-		strval := strconv.FormatInt(v, 10)
-		switch {
-		// add to the duration string if v > 1.
-		case v > 1:
-			duration += strval + " " + u.Plural + " "
-		// remove the plural 's', if v is 1.
-		case v == 1:
-			duration += strval + " " + u.Singular + " "
-		// omit any value with 0s or 0.
-		case d.duration.String() == "0" || d.duration.String() == "0s":
-			pattern := fmt.Sprintf("^-?0%s$", unitsShort[i])
-			isMatch, err := regexp.MatchString(pattern, d.input)
-			if err != nil {
-				return ""
-			}
-			if isMatch {
-				duration += strval + " " + u.Plural
-			}
+// firstUnit returns the index, in s, of the largest unit output, c.limitUnit if set.
+func (c *config) firstUnit(s UnitSystem) int {
+	if c.limitUnit == "" {
+		return 0
+	}
+	return s.index(c.limitUnit)
+}
 
-		// omit any value with 0.
-		case v == 0:
-			continue
+// commonUnit returns the index, in s, of the largest unit output not longer
+// than d, the smallest unit if there is none.
+func (c *config) commonUnit(s UnitSystem, d time.Duration) int {
+	m := magnitude(d)
+	for i := c.firstUnit(s); i < len(s); i++ {
+		if m >= uint64(s[i].Length) {
+			return i
 		}
 	}
-	// trim any remaining spaces.
-	duration = strings.TrimSpace(duration)
+	return len(s) - 1
+}
 
-	// if more than 2 spaces present return the first 2 strings
-	// if short version is requested
-	if d.limitN > 0 {
-		parts := strings.Split(duration, " ")
-		if len(parts) > d.limitN*2 {
-			duration = strings.Join(parts[:d.limitN*2], " ")
-		}
+// components breaks the duration down into the values of the units of s,
+// the first unit being d.limitUnit.
+func (d *Durafmt) components(s UnitSystem) []uint64 {
+	values := make([]uint64, len(s))
+	remaining := magnitude(d.value())
+	for i := d.firstUnit(s); i < len(s); i++ {
+		values[i] = remaining / uint64(s[i].Length)
+		remaining -= values[i] * uint64(s[i].Length)
 	}
+	return values
+}
 
-	return duration
+// format joins the non-zero components of d in the units of s, named by name.
+func (d *Durafmt) format(s UnitSystem, name func(u SystemUnit, one bool) string) string {
+	b := breakdown{units: s, input: d.input, zero: d.value() == 0, negative: d.negative(), positive: d.value() > 0}
+	if d.decimals != nil {
+		i, v := d.decimal(s)
+		b.units, b.values = s[i:i+1], []string{v}
+	} else {
+		for _, v := range d.components(s) {
+			b.values = append(b.values, strconv.FormatUint(v, 10))
+		}
+	}
+	return d.output(b, name)
 }
 
-func (d *Durafmt) InternationalString() string {
-	var duration string
+// breakdown a duration broken down into units, output by config.output.
+type breakdown struct {
+	units []SystemUnit
+	// values the decimal values of the units, "0" if zero, or with
+	// WithDecimals the decimal number of the single unit.
+	values             []string
+	input              string // Used to keep the unit of zero inputs.
+	zero               bool   // The duration is zero.
+	negative, positive bool   // The sign of the duration.
+}
 
-	// Check for minus durations.
-	if string(d.input[0]) == "-" {
-		duration += "-"
-		d.duration = -d.duration
+// output joins the non-zero values of b named by name, the first c.limitN
+// of them, with the sign. Zero durations are output in the unit of their
+// input, "0h" is "0 hours", or else seconds, and durations less than the
+// smallest unit as 0 of it.
+func (c *config) output(b breakdown, name func(u SystemUnit, one bool) string) string {
+	valueSep, sep := " ", " "
+	if c.separators != nil {
+		valueSep, sep = c.separators[0], c.separators[1]
 	}
-
-	var microseconds int64
-	var milliseconds int64
-	var seconds int64
-	var minutes int64
-	var hours int64
-	var days int64
-	var weeks int64
-	var years int64
-	var shouldConvert = false
-
-	remainingSecondsToConvert := int64(d.duration / time.Microsecond)
-
-	// Convert duration.
-	if d.limitUnit == "" {
-		shouldConvert = true
+	part := func(i int) string {
+		return b.values[i] + valueSep + name(b.units[i], b.values[i] == "1")
 	}
 
-	if d.limitUnit == "years" || shouldConvert {
-		years = remainingSecondsToConvert / (365 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= years * 365 * 24 * 3600 * 1000000
-		shouldConvert = true
+	if c.decimals != nil {
+		zero := strings.Trim(b.values[0], "0.") == ""
+		return c.sign.apply(part(0), b.negative && !zero, b.positive && !zero)
 	}
 
-	if d.limitUnit == "weeks" || shouldConvert {
-		weeks = remainingSecondsToConvert / (7 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= weeks * 7 * 24 * 3600 * 1000000
-		shouldConvert = true
+	var parts []string
+	for i, v := range b.values {
+		if v != "0" {
+			parts = append(parts, part(i))
+		}
 	}
 
-	if d.limitUnit == "days" || shouldConvert {
-		days = remainingSecondsToConvert / (24 * 3600 * 1000000)
-		remainingSecondsToConvert -= days * 24 * 3600 * 1000000
-		shouldConvert = true
+	if len(parts) == 0 {
+		// less than the smallest unit.
+		unit := len(b.units) - 1
+		if b.zero {
+			// keep the unit of zero inputs, "0h" is "0 hours", or else output seconds.
+			unit = -1
+			for i, u := range b.units {
+				if (u.Short != "" && strings.TrimPrefix(b.input, "-") == "0"+u.Short) || (unit < 0 && u.Length == time.Second) {
+					unit = i
+				}
+			}
+			if unit < 0 {
+				unit = len(b.units) - 1
+			}
+		}
+		parts = append(parts, part(unit))
 	}
 
-	if d.limitUnit == "hours" || shouldConvert {
-		hours = remainingSecondsToConvert / (3600 * 1000000)
-		remainingSecondsToConvert -= hours * 3600 * 1000000
-		shouldConvert = true
+	// if more than N parts present return the first N parts
+	// if short version is requested
+	if c.limitN > 0 && len(parts) > c.limitN {
+		parts = parts[:c.limitN]
 	}
 
-	if d.limitUnit == "minutes" || shouldConvert {
-		minutes = remainingSecondsToConvert / (60 * 1000000)
-		remainingSecondsToConvert -= minutes * 60 * 1000000
-		shouldConvert = true
-	}
+	return c.sign.apply(strings.Join(parts, sep), b.negative, b.positive)
+}
 
-	if d.limitUnit == "seconds" || shouldConvert {
-		seconds = remainingSecondsToConvert / 1000000
-		remainingSecondsToConvert -= seconds * 1000000
-		shouldConvert = true
+// decimal returns the index, in s, of the unit d is output in with
+// WithDecimals, and the unsigned decimal number of that unit.
+func (d *Durafmt) decimal(s UnitSystem) (int, string) {
+	i := d.commonUnit(s, d.value())
+	if d.limitUnit != "" {
+		i = d.firstUnit(s)
 	}
+	v := float64(magnitude(d.value())) / float64(s[i].Length)
+	return i, strconv.FormatFloat(v, 'f', *d.decimals, 64)
+}
 
-	if d.limitUnit == "milliseconds" || shouldConvert {
-		milliseconds = remainingSecondsToConvert / 1000
-		remainingSecondsToConvert -= milliseconds * 1000
-	}
+// ClockString parses d *Durafmt into a clock like duration, "H:MM:SS".
+// Hours are not wrapped into days and fractions of a second are truncated.
+func (d *Durafmt) ClockString() string {
+	duration := d.value()
+	seconds := magnitude(duration) / uint64(time.Second)
+	clock := fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	return d.sign.apply(clock, duration < 0, duration > 0)
+}
 
-	microseconds = remainingSecondsToConvert
-
-	// Create a map of the converted duration time.
-	durationMap := map[string]int64{
-		"µs": microseconds,
-		"ms": milliseconds,
-		"s":  seconds,
-		"m":  minutes,
-		"h":  hours,
-		"d":  days,
-		"w":  weeks,
-		"y":  years,
+// ISO8601String parses d *Durafmt into an ISO 8601 duration, e.g. "P14DT18H22M3.24S".
+// Days are the largest unit used, as years and months have no fixed length.
+// Negative durations always have a leading "-", whatever the SignStyle.
+func (d *Durafmt) ISO8601String() string {
+	sign := ""
+	if d.value() < 0 {
+		sign = "-"
+	}
+	duration := magnitude(d.value())
+	if duration == 0 {
+		return "PT0S"
 	}
 
-	// Construct duration string.
-	for i := range units.Units() {
-		u := unitsShort[i]
-		v := durationMap[u]
-		strval := strconv.FormatInt(v, 10)
-		switch {
-		// add to the duration string if v > 0.
-		case v > 0:
-			duration += strval + " " + u + " "
-		// omit any value with 0.
-		case d.duration.String() == "0":
-			pattern := fmt.Sprintf("^-?0%s$", unitsShort[i])
-			isMatch, err := regexp.MatchString(pattern, d.input)
-			if err != nil {
-				return ""
-			}
-			if isMatch {
-				duration += strval + " " + u
-			}
+	days := duration / uint64(24*time.Hour)
+	duration -= days * uint64(24*time.Hour)
+	hours := duration / uint64(time.Hour)
+	duration -= hours * uint64(time.Hour)
+	minutes := duration / uint64(time.Minute)
+	duration -= minutes * uint64(time.Minute)
 
-		// omit any value with 0.
-		case v == 0:
-			continue
-		}
+	iso := sign + "P"
+	if days > 0 {
+		iso += strconv.FormatUint(days, 10) + "D"
 	}
-	// trim any remaining spaces.
-	duration = strings.TrimSpace(duration)
-
-	// if more than 2 spaces present return the first 2 strings
-	// if short version is requested
-	if d.limitN > 0 {
-		parts := strings.Split(duration, " ")
-		if len(parts) > d.limitN*2 {
-			duration = strings.Join(parts[:d.limitN*2], " ")
+	if duration == 0 && hours == 0 && minutes == 0 {
+		return iso
+	}
+	iso += "T"
+	if hours > 0 {
+		iso += strconv.FormatUint(hours, 10) + "H"
+	}
+	if minutes > 0 {
+		iso += strconv.FormatUint(minutes, 10) + "M"
+	}
+	if duration > 0 {
+		seconds := strconv.FormatUint(duration/uint64(time.Second), 10)
+		if frac := duration % uint64(time.Second); frac > 0 {
+			seconds += strings.TrimRight(fmt.Sprintf(".%09d", frac), "0")
 		}
+		iso += seconds + "S"
 	}
-
-	return duration
+	return iso
 }
@@ -2,7 +2,10 @@ package durafmt
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"testing"
+	"testing/quick"
 	"time"
 )
 
@@ -402,6 +405,7 @@ func TestInvalidDuration(t *testing.T) {
 		{"1nmd", ""},
 		{"0", ""},
 		{"-0", ""},
+		{"+0", ""},
 	}
 
 	for _, table := range testStrings {
@@ -445,6 +449,8 @@ func TestInternationalString(t *testing.T) {
 		{Parse(168 * time.Hour), "1 w"},
 		{Parse(170 * time.Hour), "1 w 2 h"},
 		{Parse(336 * time.Hour), "2 w"},
+		{Parse(0), "0 s"},
+		{Parse(-65 * time.Second), "-1 m 5 s"},
 	}
 
 	for _, table := range testStrings {
@@ -480,3 +486,95 @@ func BenchmarkParseString(b *testing.B) {
 		}
 	}
 }
+
+// TestExtremes for durations at the limits of time.Duration.
+func TestExtremes(t *testing.T) {
+	var testStrings = []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(math.MaxInt64), "292 years 24 weeks 3 days 23 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds"},
+		{Parse(math.MinInt64), "-292 years 24 weeks 3 days 23 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds"},
+		{Parse(math.MinInt64).LimitFirstN(2), "-292 years 24 weeks"},
+		{Parse(math.MinInt64).LimitToUnit("hours"), "-2562047 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds"},
+		{Parse(math.MinInt64).LimitToUnit("milliseconds"), "-9223372036854 milliseconds 775 microseconds"},
+		{Parse(math.MinInt64).LimitToUnit("microseconds"), "-9223372036854775 microseconds"},
+		{Parse(-1), "-0 microseconds"},
+		{Parse(999), "0 microseconds"},
+		{Parse(0), "0 seconds"},
+	}
+
+	for _, table := range testStrings {
+		if actual := table.test.String(); actual != table.expected {
+			t.Errorf("String() = %q, expected %q", actual, table.expected)
+		}
+	}
+
+	d, err := ParseString("-2562047h47m16.854775808s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Duration() != math.MinInt64 {
+		t.Errorf("ParseString(MinInt64).Duration() = %v", d.Duration())
+	}
+	if got := d.InternationalString(); got != "-292 y 24 w 3 d 23 h 47 m 16 s 854 ms 775 µs" {
+		t.Errorf("InternationalString() = %q", got)
+	}
+	if got := d.ClockString(); got != "-2562047:47:16" {
+		t.Errorf("ClockString() = %q", got)
+	}
+	if got := d.ISO8601String(); got != "-P106751DT23H47M16.854775808S" {
+		t.Errorf("ISO8601String() = %q", got)
+	}
+	for _, input := range []string{"2562047h47m16.854775808s", "-2562047h47m16.854775809s", "9999999999h"} {
+		if _, err := ParseString(input); err == nil {
+			t.Errorf("ParseString(%q). got nil error, expected an error", input)
+		}
+	}
+}
+
+// TestRepeatedFormat for formatting the same *Durafmt more than once.
+func TestRepeatedFormat(t *testing.T) {
+	d := Parse(-100 * time.Second)
+	for i := 0; i < 3; i++ {
+		if got := d.String(); got != "-1 minute 40 seconds" {
+			t.Errorf("String() call %d = %q", i, got)
+		}
+		if got := d.InternationalString(); got != "-1 m 40 s" {
+			t.Errorf("InternationalString() call %d = %q", i, got)
+		}
+	}
+	if d.Duration() != -100*time.Second {
+		t.Errorf("Duration() = %v after formatting", d.Duration())
+	}
+}
+
+// TestSignProperty for the sign of the output of every duration.
+func TestSignProperty(t *testing.T) {
+	property := func(n int64) bool {
+		d := Parse(time.Duration(n))
+		outputs := []string{
+			d.String(),
+			d.InternationalString(),
+			d.ClockString(),
+			d.ISO8601String(),
+			Parse(time.Duration(n)).LimitFirstN(1).String(),
+			Parse(time.Duration(n)).LimitToUnit("days").String(),
+		}
+		for _, s := range outputs {
+			if s == "" || (n < 0) != strings.HasPrefix(s, "-") {
+				t.Logf("Parse(%d) = %q", n, s)
+				return false
+			}
+		}
+		return true
+	}
+	for _, n := range []int64{math.MinInt64, math.MinInt64 + 1, -1001, -1000, -1, 0, 1, 999, 1000, math.MaxInt64 - 1, math.MaxInt64} {
+		if !property(n) {
+			t.Errorf("sign property failed for %d", n)
+		}
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 10000}); err != nil {
+		t.Error(err)
+	}
+}
@@ -86,3 +86,100 @@ func ExampleDurafmt_Format() {
 	}
 	fmt.Println(duration.Format(units)) // 2 SEMANAS 18 horas 22 minutos 1 segundo 100 microssegundos
 }
+
+func ExampleNew() {
+	timeduration := (354 * time.Hour) + (22 * time.Minute) + (3 * time.Second)
+	duration := New(timeduration, WithLocale("pt"), WithLimitFirstN(2), WithSeparators(" ", ", "))
+	fmt.Println(duration) // 2 semanas, 18 horas
+}
+
+func ExampleFormatter() {
+	short := NewFormatter(WithLayout(LayoutInternational), WithLimitFirstN(2))
+	fmt.Println(short.Format(354 * time.Hour))  // 2 w 18 h
+	fmt.Println(short.Format(90 * time.Second)) // 1 m 30 s
+}
+
+func ExampleFormatColumn() {
+	runtimes := []time.Duration{2*time.Hour + 3*time.Minute, 45 * time.Minute, 12 * time.Hour}
+	for _, cell := range FormatColumn(runtimes) {
+		fmt.Printf("[%s]\n", cell)
+	}
+	// Output:
+	// [ 2.0 hours]
+	// [ 0.8 hours]
+	// [12.0 hours]
+}
+
+func ExampleTicks() {
+	for _, tick := range Ticks(50*time.Second, 3*time.Minute, 6) {
+		fmt.Println(tick.Label)
+	}
+	// Output:
+	// 1m
+	// 1m30s
+	// 2m
+	// 2m30s
+	// 3m
+}
+
+func ExampleDurafmt_Fit() {
+	d := Parse(354*time.Hour + 22*time.Minute + 3*time.Second)
+	for _, width := range []int{40, 20, 8, 4} {
+		fmt.Printf("%q\n", d.Fit(width))
+	}
+	// Output:
+	// "2 weeks 18 hours 22 minutes 3 seconds"
+	// "2 w 18 h 22 m 3 s"
+	// "2w18h22m"
+	// "2.1w"
+}
+
+func ExampleCalendar_ParseBetween() {
+	c := NewCalendar(9*time.Hour, 18*time.Hour)
+	friday := time.Date(2024, time.March, 1, 17, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+	fmt.Println(c.ParseBetween(friday, monday))
+	fmt.Println(c.ParseBetween(friday, monday.AddDate(0, 0, 1)))
+	// Output:
+	// 2 working hours
+	// 1 working day 3 working hours
+}
+
+func ExampleNewUnitSystem() {
+	shifts, _ := NewUnitSystem(
+		SystemUnit{Unit{"sprint", "sprints"}, "sp", 2 * 7 * 24 * time.Hour},
+		SystemUnit{Unit{"shift", "shifts"}, "sh", 8 * time.Hour},
+		SystemUnit{Unit{"hour", "hours"}, "h", time.Hour},
+	)
+	d := 3*7*24*time.Hour + 17*time.Hour
+	fmt.Println(Parse(d).FormatSystem(shifts))
+	fmt.Println(New(d, WithUnitSystem(shifts), WithLimitToUnit("shifts"), WithLayout(LayoutInternational)))
+	// Output:
+	// 1 sprint 23 shifts 1 hour
+	// 65 sh 1 h
+}
+
+func ExampleParseSystem() {
+	d, _ := ParseSystem("3 sols 4 hours", MarsUnitSystem)
+	fmt.Println(d)
+	fmt.Println(d.Convert(DefaultUnitSystem).LimitFirstN(2))
+	fmt.Println(d.Convert(SiderealUnitSystem).LimitFirstN(2))
+	// Output:
+	// 3 sols 4 hours
+	// 3 days 5 hours
+	// 3 sidereal days 6 hours
+}
+
+func ExampleEvery() {
+	fmt.Println(Every(15 * time.Minute))
+	fmt.Println(Every(time.Minute))
+	fmt.Println(Every(time.Hour))
+	fmt.Println(NewFormatter(WithLocale("de")).Every(15 * time.Minute))
+	fmt.Println(Rate(1000, 2*time.Hour))
+	// Output:
+	// every 15 minutes
+	// every minute
+	// hourly
+	// alle 15 Minuten
+	// 500 per hour
+}
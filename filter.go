@@ -0,0 +1,155 @@
+package durafmt
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultFilterMaxLen default longest Go duration, in bytes, rewritten by Filter.
+const DefaultFilterMaxLen = 64
+
+// filterBufSize longest line, in bytes, Filter looks for durations in at once.
+// Longer lines are processed in chunks of this size, see filterCut.
+const filterBufSize = 64 * 1024
+
+// filterRunes the runes Go durations are made of.
+const filterRunes = "0123456789.+-nsuµμmh"
+
+var goDurationRe = regexp.MustCompile(`[-+]?(?:(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)(?:ns|us|µs|μs|ms|s|m|h))+`)
+
+// FilterOptions configures Filter and FilterString.
+type FilterOptions struct {
+	// Style renders the durations found, LongStyle if nil.
+	Style Style
+	// Allow if non-nil, only durations matching Allow are rewritten.
+	Allow *regexp.Regexp
+	// Deny if non-nil, durations matching Deny are left untouched.
+	Deny *regexp.Regexp
+	// MaxLen longest duration rewritten, in bytes, DefaultFilterMaxLen if 0.
+	MaxLen int
+}
+
+// Filter copies r to w line by line, rewriting every Go duration embedded in
+// the text, e.g. "took 1m30.5s", with opts.Style.
+// Each line is written, and w flushed if it has a `Flush() error` method, as
+// soon as it is read, so Filter can be used on streams like `tail -f`.
+// Lines longer than 64 KiB are written in chunks, a duration is never split.
+func Filter(w io.Writer, r io.Reader, opts FilterOptions) error {
+	flusher, _ := w.(interface{ Flush() error })
+	br := bufio.NewReaderSize(r, filterBufSize)
+	// The end of the previous chunk of a long line, filtered with the next
+	// chunk, preceded by skip bytes of context already written.
+	var carry string
+	skip := 0
+	for {
+		line, err := br.ReadSlice('\n')
+		if len(line) > 0 || carry != "" {
+			s := carry + string(line)
+			prefix := skip
+			carry, skip = "", 0
+			if err == bufio.ErrBufferFull {
+				cut, context := filterCut(s, opts.maxLen())
+				s, carry, skip = s[:cut], context+s[cut:], len(context)
+			}
+			if _, werr := io.WriteString(w, FilterString(s, opts)[prefix:]); werr != nil {
+				return werr
+			}
+			if flusher != nil {
+				if ferr := flusher.Flush(); ferr != nil {
+					return ferr
+				}
+			}
+		}
+		switch err {
+		case nil, bufio.ErrBufferFull:
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// filterCut splits the chunk s of a long line before the duration it may end
+// with, to be filtered with the next chunk. It returns the length of the
+// start of s to filter now, up to the last rune that is not part of a
+// duration, and that rune, the context of the rest of s for filterBoundary.
+// Runs of duration runes longer than maxLen are not split, their context is "_"
+// so that the next chunk does not start a duration.
+func filterCut(s string, maxLen int) (int, string) {
+	for i := len(s); i > 0 && len(s)-i <= maxLen; {
+		r, size := utf8.DecodeLastRuneInString(s[:i])
+		if !strings.ContainsRune(filterRunes, r) {
+			return i, s[i-size : i]
+		}
+		i -= size
+	}
+	return len(s), "_"
+}
+
+// maxLen returns opts.MaxLen, DefaultFilterMaxLen if 0.
+func (opts FilterOptions) maxLen() int {
+	if opts.MaxLen <= 0 {
+		return DefaultFilterMaxLen
+	}
+	return opts.MaxLen
+}
+
+// FilterString returns s with every Go duration rewritten with opts.Style.
+// Durations must stand alone: "5s" is rewritten in "took 5s." but not in "v5s" or "5sec".
+func FilterString(s string, opts FilterOptions) string {
+	style := opts.Style
+	if style == nil {
+		style = LongStyle
+	}
+	maxLen := opts.maxLen()
+
+	var out []byte
+	last := 0
+	for _, loc := range goDurationRe.FindAllStringIndex(s, -1) {
+		start, end := loc[0], loc[1]
+		match := s[start:end]
+		if end-start > maxLen || !filterBoundary(s, start, end) {
+			continue
+		}
+		if opts.Allow != nil && !opts.Allow.MatchString(match) {
+			continue
+		}
+		if opts.Deny != nil && opts.Deny.MatchString(match) {
+			continue
+		}
+		d, err := time.ParseDuration(match)
+		if err != nil {
+			continue
+		}
+		out = append(out, s[last:start]...)
+		out = append(out, style(d)...)
+		last = end
+	}
+	if out == nil {
+		return s
+	}
+	return string(append(out, s[last:]...))
+}
+
+// filterBoundary reports whether s[start:end] is not part of a longer word or number.
+func filterBoundary(s string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(s[:start])
+		if r == '.' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	if end < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[end:])
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
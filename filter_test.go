@@ -0,0 +1,98 @@
+package durafmt
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestFilterString(t *testing.T) {
+	tests := []struct {
+		test     string
+		opts     FilterOptions
+		expected string
+	}{
+		{"took 1m30.5s", FilterOptions{}, "took 1 minute 30 seconds 500 milliseconds"},
+		{"took 1m30.5s.", FilterOptions{Style: ShortStyle}, "took 1 minute."},
+		{"a 2h45m0s b -5s c", FilterOptions{}, "a 2 hours 45 minutes b -5 seconds c"},
+		{"(250ms), [3µs]", FilterOptions{}, "(250 milliseconds), [3 microseconds]"},
+		{"v1.2s build_5s 5sec 10m3x", FilterOptions{}, "v1.2s build_5s 5sec 10m3x"},
+		{"no durations here", FilterOptions{}, "no durations here"},
+		{"2h 250ms", FilterOptions{Style: InternationalStyle, Deny: regexp.MustCompile(`ms$`)}, "2 h 250ms"},
+		{"2h 250ms", FilterOptions{Allow: regexp.MustCompile(`ms$`)}, "2h 250 milliseconds"},
+		{"1h2m3s4ms 5s", FilterOptions{MaxLen: 4}, "1h2m3s4ms 5 seconds"},
+		{"99999999999h", FilterOptions{}, "99999999999h"},
+	}
+
+	for _, tt := range tests {
+		if got := FilterString(tt.test, tt.opts); got != tt.expected {
+			t.Errorf("FilterString(%q) = %q, expected %q", tt.test, got, tt.expected)
+		}
+	}
+}
+
+// flushWriter records the output written at each flush.
+type flushWriter struct {
+	bytes.Buffer
+	flushed []string
+}
+
+func (w *flushWriter) Flush() error {
+	w.flushed = append(w.flushed, w.String())
+	return nil
+}
+
+func TestFilter(t *testing.T) {
+	input := "start\nserved in 1m30s\n\nlast 2s"
+	var w flushWriter
+	if err := Filter(&w, strings.NewReader(input), FilterOptions{Style: ShortStyle}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "start\nserved in 1 minute\n\nlast 2 seconds"
+	if w.String() != expected {
+		t.Errorf("Filter() = %q, expected %q", w.String(), expected)
+	}
+	if len(w.flushed) != 4 || w.flushed[1] != "start\nserved in 1 minute\n" {
+		t.Errorf("Filter() flushed %q, expected a flush per line", w.flushed)
+	}
+}
+
+func TestFilter_LongLine(t *testing.T) {
+	long := strings.Repeat("x", filterBufSize+10) + " 5s\n"
+	var out bytes.Buffer
+	if err := Filter(&out, strings.NewReader(long+"5s\n"), FilterOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Repeat("x", filterBufSize+10) + " 5 seconds\n5 seconds\n"
+	if out.String() != expected {
+		t.Errorf("Filter() of long line, got %d bytes, expected %d", out.Len(), len(expected))
+	}
+}
+
+func TestFilter_ChunkBoundary(t *testing.T) {
+	// The reader returns long lines in chunks of filterBufSize bytes.
+	pad := strings.Repeat("x", filterBufSize-4)
+	tests := []struct {
+		test     string
+		expected string
+	}{
+		{pad + " 1h30m done\n", pad + " 1 hour 30 minutes done\n"},
+		{pad + " 1h30m", pad + " 1 hour 30 minutes"},
+		{pad + "xxx5s\n", pad + "xxx5s\n"},
+		{pad + "xx.5s 2s\n", pad + "xx.5s 2 seconds\n"},
+		{pad + " é1h30m\n", pad + " é1h30m\n"},
+		{pad + "é 1h30m\n", pad + "é 1 hour 30 minutes\n"},
+		{pad[:len(pad)-100] + " " + strings.Repeat("5", 200) + "s 3s\n", pad[:len(pad)-100] + " " + strings.Repeat("5", 200) + "s 3 seconds\n"},
+	}
+	for i, tt := range tests {
+		var out bytes.Buffer
+		if err := Filter(&out, strings.NewReader(tt.test), FilterOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != tt.expected {
+			t.Errorf("%d: Filter() = ...%q, expected ...%q", i, out.String()[len(pad)-10:], tt.expected[len(pad)-10:])
+		}
+	}
+}
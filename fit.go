@@ -0,0 +1,112 @@
+package durafmt
+
+import (
+	"time"
+	"unicode"
+)
+
+// wideRanges the east-Asian wide and fullwidth runes, two cells wide in
+// monospaced output.
+var wideRanges = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115f, 1},
+		{0x2e80, 0x303e, 1},
+		{0x3041, 0x33ff, 1},
+		{0x3400, 0x4dbf, 1},
+		{0x4e00, 0x9fff, 1},
+		{0xa000, 0xa4cf, 1},
+		{0xac00, 0xd7a3, 1},
+		{0xf900, 0xfaff, 1},
+		{0xfe30, 0xfe4f, 1},
+		{0xff00, 0xff60, 1},
+		{0xffe0, 0xffe6, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1f300, 0x1f64f, 1},
+		{0x1f900, 0x1f9ff, 1},
+		{0x20000, 0x2fffd, 1},
+		{0x30000, 0x3fffd, 1},
+	},
+}
+
+// displayWidth returns the width of s in cells of monospaced output: one per
+// rune, two for east-Asian wide runes and none for combining marks.
+func displayWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+		case unicode.Is(wideRanges, r):
+			w += 2
+		default:
+			w++
+		}
+	}
+	return w
+}
+
+// Fit parses d *Durafmt into the most precise human readable duration at most
+// width cells wide, runes counting one cell and east-Asian wide runes two.
+//
+// Each output is tried with the units of d, then with international units,
+// then with international units without separators, "2w18h". The outputs are
+// the first n components of d, for n from all of them down to 2, then, if
+// components were dropped, a single unit with one decimal, "2.8 weeks", and
+// rounded, "3 weeks", or else the single component, "2 weeks".
+// If nothing fits, the last, narrowest, output is returned.
+//
+// The other output options of d, like the sign, locale and limit unit, apply.
+func (d *Durafmt) Fit(width int) string {
+	c := *d
+	c.layout = LayoutLong
+	c.decimals = nil
+
+	count := 0
+	for _, v := range c.components(c.unitSystem()) {
+		if v > 0 {
+			count++
+		}
+	}
+	n := count
+	if c.limitN > 0 && c.limitN < n {
+		n = c.limitN
+	}
+
+	var s string
+	fits := func(c Durafmt) bool {
+		compact := c
+		compact.separators = &[2]string{"", ""}
+		for _, candidate := range []func() string{c.String, c.InternationalString, compact.InternationalString} {
+			s = candidate()
+			if displayWidth(s) <= width {
+				return true
+			}
+		}
+		return false
+	}
+	for ; n > 1; n-- {
+		c.limitN = n
+		if fits(c) {
+			return s
+		}
+	}
+	c.limitN = 1
+	if count <= 1 {
+		fits(c)
+		return s
+	}
+	c.limitN = 0
+	for _, decimals := range []int{1, 0} {
+		decimals := decimals
+		c.decimals = &decimals
+		if fits(c) {
+			return s
+		}
+	}
+	return s
+}
+
+// Fit formats d like `f.Parse(d).Fit(width)`.
+func (f Formatter) Fit(d time.Duration, width int) string {
+	return f.Parse(d).Fit(width)
+}
@@ -0,0 +1,73 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected int
+	}{
+		{"", 0},
+		{"2 weeks", 7},
+		{"2 µs", 4},
+		{"2 週", 4},
+		{"３時間", 6},
+		{"2 분", 4},
+		{"é", 1},
+	}
+	for _, tt := range tests {
+		if got := displayWidth(tt.s); got != tt.expected {
+			t.Errorf("displayWidth(%q) = %d, expected %d", tt.s, got, tt.expected)
+		}
+	}
+}
+
+func TestDurafmt_Fit(t *testing.T) {
+	d := 354*time.Hour + 22*time.Minute + 3*time.Second
+	tests := []struct {
+		d        time.Duration
+		width    int
+		expected string
+	}{
+		{d, 100, "2 weeks 18 hours 22 minutes 3 seconds"},
+		{d, 36, "2 w 18 h 22 m 3 s"},
+		{d, 20, "2 w 18 h 22 m 3 s"},
+		{d, 16, "2w18h22m3s"},
+		{d, 9, "2w18h22m"},
+		{d, 6, "2w18h"},
+		{d, 5, "2w18h"},
+		{d, 4, "2.1w"},
+		{d, 3, "2 w"},
+		{d, 0, "2w"},
+		{d + 6*24*time.Hour, 2, "3w"},
+		{-d, 5, "-2.1w"},
+		{2 * time.Hour, 100, "2 hours"},
+		{2 * time.Hour, 3, "2 h"},
+		{2 * time.Hour, 1, "2h"},
+		{0, 100, "0 seconds"},
+		{0, 1, "0s"},
+		{90 * time.Minute, 8, "1 h 30 m"},
+		{90 * time.Minute, 4, "1.5h"},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.d).Fit(tt.width); got != tt.expected {
+			t.Errorf("Parse(%v).Fit(%d) = %q, expected %q", tt.d, tt.width, got, tt.expected)
+		}
+	}
+
+	// The other options apply.
+	if got := New(-d, WithSign(SignAgo), WithLimitToUnit("days")).Fit(12); got != "14d18h ago" {
+		t.Errorf("Fit() with options = %q", got)
+	}
+	wide := Units{Year: Unit{"年", "年"}, Week: Unit{"週", "週"}, Day: Unit{"日", "日"}, Hour: Unit{"時間", "時間"},
+		Minute: Unit{"分", "分"}, Second: Unit{"秒", "秒"}, Millisecond: Unit{"ミリ秒", "ミリ秒"}, Microsecond: Unit{"マイクロ秒", "マイクロ秒"}}
+	if got := New(d, WithUnits(wide)).Fit(23); got != "2 週 18 時間 22 分 3 秒" {
+		t.Errorf("Fit() with wide units = %q", got)
+	}
+	if got := NewFormatter(WithUnits(wide)).Fit(d, 22); got != "2 w 18 h 22 m 3 s" {
+		t.Errorf("Formatter.Fit() with wide units = %q", got)
+	}
+}
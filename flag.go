@@ -0,0 +1,74 @@
+package durafmt
+
+import (
+	"flag"
+	"time"
+)
+
+// DurationValue is a flag.Value for time.Duration flags.
+// It accepts Go syntax (`"2m30s"`) as well as the human readable form of any
+// registered locale (`"2 minutes 30 seconds"`), and shows its value in human
+// readable form, so the defaults printed by -help read like durafmt output.
+// The zero DurationValue is ready to use, it stores its value itself.
+type DurationValue struct {
+	p *time.Duration
+}
+
+// NewDurationValue creates a new *DurationValue storing its value in p,
+// and sets *p to value.
+func NewDurationValue(p *time.Duration, value time.Duration) *DurationValue {
+	*p = value
+	return &DurationValue{p}
+}
+
+// Set parses s as a Go duration or, failing that, as a human readable duration.
+func (v *DurationValue) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		h, herr := parseHuman(s, nil)
+		if herr != nil {
+			return herr
+		}
+		d = h
+	}
+	if v.p == nil {
+		v.p = new(time.Duration)
+	}
+	*v.p = d
+	return nil
+}
+
+// String returns the value in human readable form.
+func (v *DurationValue) String() string {
+	if v == nil || v.p == nil {
+		return Parse(0).String()
+	}
+	return Parse(*v.p).String()
+}
+
+// Get returns the value as a time.Duration, it implements flag.Getter.
+func (v *DurationValue) Get() interface{} {
+	if v.p == nil {
+		return time.Duration(0)
+	}
+	return *v.p
+}
+
+// DurationVar defines a time.Duration flag with the specified name, default value
+// and usage string in fs, flag.CommandLine if fs is nil.
+// The argument p points to a time.Duration variable in which to store the value of the flag.
+func DurationVar(fs *flag.FlagSet, p *time.Duration, name string, value time.Duration, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	fs.Var(NewDurationValue(p, value), name, usage)
+}
+
+// Duration defines a time.Duration flag with the specified name, default value
+// and usage string in fs, flag.CommandLine if fs is nil.
+// The return value is the address of a time.Duration variable that stores the value of the flag.
+func Duration(fs *flag.FlagSet, name string, value time.Duration, usage string) *time.Duration {
+	p := new(time.Duration)
+	DurationVar(fs, p, name, value, usage)
+	return p
+}
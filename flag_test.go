@@ -0,0 +1,79 @@
+package durafmt
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurationValue(t *testing.T) {
+	tests := []struct {
+		arg      string
+		expected time.Duration
+	}{
+		{"2m30s", 150 * time.Second},
+		{"0", 0},
+		{"2 minutes 30 seconds", 150 * time.Second},
+		{"1 h 5 m", 65 * time.Minute},
+		{"3 horas", 3 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		timeout := Duration(fs, "timeout", time.Minute, "timeout")
+		if err := fs.Parse([]string{"-timeout", tt.arg}); err != nil {
+			t.Errorf("Parse(%q): %v", tt.arg, err)
+			continue
+		}
+		if *timeout != tt.expected {
+			t.Errorf("Parse(%q) = %v, expected %v", tt.arg, *timeout, tt.expected)
+		}
+		if got := fs.Lookup("timeout").Value.(flag.Getter).Get(); got != tt.expected {
+			t.Errorf("Get() = %v, expected %v", got, tt.expected)
+		}
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(new(bytes.Buffer))
+	Duration(fs, "timeout", time.Minute, "timeout")
+	if err := fs.Parse([]string{"-timeout", "2 fortnights"}); err == nil {
+		t.Errorf("Parse(%q). got nil error, expected an error", "2 fortnights")
+	}
+}
+
+func TestDurationValue_Default(t *testing.T) {
+	var out bytes.Buffer
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&out)
+	var timeout, zero time.Duration
+	DurationVar(fs, &timeout, "timeout", 150*time.Second, "request `timeout`")
+	DurationVar(fs, &zero, "zero", 0, "zero default")
+	fs.PrintDefaults()
+
+	if !strings.Contains(out.String(), "(default 2 minutes 30 seconds)") {
+		t.Errorf("PrintDefaults() = %q, expected human readable default", out.String())
+	}
+	if strings.Count(out.String(), "(default") != 1 {
+		t.Errorf("PrintDefaults() = %q, expected zero default to be omitted", out.String())
+	}
+}
+
+func TestDurationValue_Zero(t *testing.T) {
+	v := new(DurationValue)
+	if got := v.Get(); got != time.Duration(0) {
+		t.Errorf("Get() of zero DurationValue = %v", got)
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(v, "t", "")
+	if err := fs.Parse([]string{"-t", "1m"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := v.Get(); got != time.Minute {
+		t.Errorf("Get() = %v, expected 1m", got)
+	}
+	if got := v.String(); got != "1 minute" {
+		t.Errorf("String() = %q", got)
+	}
+}
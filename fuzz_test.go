@@ -0,0 +1,125 @@
+//go:build go1.18
+// +build go1.18
+
+package durafmt
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// FuzzFormat checks every time.Duration formats without panicking, with the sign of the duration.
+func FuzzFormat(f *testing.F) {
+	for _, n := range []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, 999, 1000, math.MaxInt64} {
+		f.Add(n, 0, "")
+	}
+	f.Add(int64(-100*time.Second), 1, "minutes")
+	f.Add(int64(87593183*time.Second), 2, "weeks")
+
+	f.Fuzz(func(t *testing.T, n int64, limitN int, limitUnit string) {
+		d := time.Duration(n)
+		for _, s := range []string{
+			Parse(d).LimitFirstN(limitN).LimitToUnit(limitUnit).String(),
+			Parse(d).LimitFirstN(limitN).LimitToUnit(limitUnit).InternationalString(),
+			Parse(d).ClockString(),
+			Parse(d).ISO8601String(),
+		} {
+			if s == "" {
+				t.Fatalf("Parse(%d) formatted to an empty string", n)
+			}
+			if (n < 0) != strings.HasPrefix(s, "-") {
+				t.Fatalf("Parse(%d) = %q, sign does not match", n, s)
+			}
+		}
+	})
+}
+
+// displayed returns the part of d shown by String, truncated to the microsecond.
+func displayed(d time.Duration) time.Duration {
+	return d.Truncate(time.Microsecond)
+}
+
+// FuzzParseString checks valid Go durations format and parse back at the displayed precision.
+func FuzzParseString(f *testing.F) {
+	for _, s := range []string{"354h22m3.24s", "0s", "-0h", "1.5µs", "-2562047h47m16.854775808s", "0", "+0", "1d"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		d, err := ParseString(input)
+		expected, perr := time.ParseDuration(input)
+		if err != nil {
+			if perr == nil && input != "0" && input != "-0" && input != "+0" {
+				t.Fatalf("ParseString(%q): %v, time.ParseDuration accepts it", input, err)
+			}
+			return
+		}
+		if perr != nil || d.Duration() != expected {
+			t.Fatalf("ParseString(%q).Duration() = %v, expected %v (%v)", input, d.Duration(), expected, perr)
+		}
+
+		s := d.String()
+		h, err := ParseHuman(s, "en")
+		if err != nil {
+			t.Fatalf("ParseHuman(%q) of ParseString(%q): %v", s, input, err)
+		}
+		if h.Duration() != displayed(expected) {
+			t.Fatalf("ParseHuman(%q).Duration() = %v, expected %v", s, h.Duration(), displayed(expected))
+		}
+	})
+}
+
+// FuzzFormatUnits checks Format with any units decoded by UnitsCoder.Decode.
+func FuzzFormatUnits(f *testing.F) {
+	f.Add("year,week,day,hour,minute,second,millisecond,microsecond", int64(time.Hour), 0)
+	f.Add("ano,semana:SEMANAS,dia,hora,minuto,segundo,milissegundo,microssegundo", int64(-354*time.Hour), 2)
+	f.Add("y:,w:,d:,h:,m:,s:,ms:,µs:", int64(math.MinInt64), 1)
+
+	f.Fuzz(func(t *testing.T, encoded string, n int64, limitN int) {
+		units, err := DefaultUnitsCoder.Decode(encoded)
+		if err != nil {
+			return
+		}
+		decoded, err := DefaultUnitsCoder.Decode(DefaultUnitsCoder.Encode(units))
+		if err != nil || decoded != units {
+			t.Fatalf("Decode(Encode(%v)) = %v, %v", units, decoded, err)
+		}
+
+		s := Parse(time.Duration(n)).LimitFirstN(limitN).Format(units)
+		if (n < 0) != strings.HasPrefix(s, "-") {
+			t.Fatalf("Parse(%d).Format(%v) = %q, sign does not match", n, units, s)
+		}
+	})
+}
+
+// FuzzInternationalString checks international strings parse back at the displayed precision.
+func FuzzInternationalString(f *testing.F) {
+	for _, n := range []int64{0, 1000, -65 * int64(time.Second), int64(170 * time.Hour), math.MinInt64, math.MaxInt64} {
+		f.Add(n, 0)
+	}
+	f.Add(int64(17519*time.Hour), 1)
+
+	f.Fuzz(func(t *testing.T, n int64, limitN int) {
+		d := time.Duration(n)
+		s := Parse(d).LimitFirstN(limitN).InternationalString()
+		h, err := ParseHuman(s, "en")
+		if err != nil {
+			t.Fatalf("ParseHuman(%q) of Parse(%d).InternationalString(): %v", s, n, err)
+		}
+
+		// The difference is less than the smallest unit shown.
+		diff := magnitude(displayed(d)) - magnitude(h.Duration())
+		smallest := uint64(unitLengths[len(unitLengths)-1])
+		parts := strings.Fields(strings.TrimPrefix(s, "-"))
+		for i, u := range unitsShort {
+			if u == parts[len(parts)-1] {
+				smallest = uint64(unitLengths[i])
+			}
+		}
+		if magnitude(h.Duration()) > magnitude(d) || diff >= smallest {
+			t.Fatalf("ParseHuman(%q).Duration() = %v, expected %v to the %v", s, h.Duration(), d, time.Duration(smallest))
+		}
+	})
+}
@@ -0,0 +1,31 @@
+// Package httplocale provides net/http middleware negotiating the durafmt
+// locale of requests from their Accept-Language header.
+//
+//	handler := httplocale.Handler(mux, durafmt.WithLimitFirstN(2))
+//
+// Handlers format durations in the language of the request:
+//
+//	fmt.Fprintln(w, httplocale.FromRequest(r).Format(elapsed)) // 1 dia 2 horas
+package httplocale
+
+import (
+	"net/http"
+
+	"github.com/hako/durafmt"
+)
+
+// Handler returns a handler that puts a Formatter with opts, negotiated
+// from the Accept-Language header, in the request context and calls next.
+// The Formatter is returned by FromRequest and durafmt.FromContext.
+func Handler(next http.Handler, opts ...durafmt.Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Language")
+		f := durafmt.NegotiateFormatter(r.Header.Get("Accept-Language"), opts...)
+		next.ServeHTTP(w, r.WithContext(durafmt.WithFormatter(r.Context(), f)))
+	})
+}
+
+// FromRequest returns the Formatter of the request context, see durafmt.FromContext.
+func FromRequest(r *http.Request) durafmt.Formatter {
+	return durafmt.FromContext(r.Context())
+}
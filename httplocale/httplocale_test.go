@@ -0,0 +1,43 @@
+package httplocale
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hako/durafmt"
+)
+
+func TestHandler(t *testing.T) {
+	handler := Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(FromRequest(r).Format(26 * time.Hour)))
+	}), durafmt.WithSeparators(" ", ", "))
+
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{"", "1 day, 2 hours"},
+		{"pt-BR, en;q=0.5", "1 dia, 2 horas"},
+		{"fr;q=0.5, de", "1 Tag, 2 Stunden"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/", nil)
+		if tt.header != "" {
+			r.Header.Set("Accept-Language", tt.header)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Body.String() != tt.expected {
+			t.Errorf("Accept-Language %q got %q, expected %q", tt.header, w.Body.String(), tt.expected)
+		}
+		if w.Header().Get("Vary") != "Accept-Language" {
+			t.Errorf("Vary = %q", w.Header().Get("Vary"))
+		}
+	}
+
+	if got := FromRequest(httptest.NewRequest("GET", "/", nil)).Format(time.Hour); got != "1 hour" {
+		t.Errorf("FromRequest() without Handler = %q", got)
+	}
+}
@@ -0,0 +1,216 @@
+package durafmt
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// unitLengths lengths of the units in the order returned by `Units.Units()`.
+var unitLengths = []time.Duration{
+	365 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	24 * time.Hour,
+	time.Hour,
+	time.Minute,
+	time.Second,
+	time.Millisecond,
+	time.Microsecond,
+}
+
+// humanName a unit name accepted by ParseHuman.
+type humanName struct {
+	name   string
+	length time.Duration
+}
+
+// humanNames returns the unit names of the locales with tags, or of all
+// registered locales if tags is empty, longest names first.
+func humanNames(tags []string) ([]humanName, error) {
+	if len(tags) == 0 {
+		tags = Locales()
+	}
+	var names []humanName
+	for _, tag := range tags {
+		l, ok := LookupLocale(tag)
+		if !ok {
+			return nil, errors.New("durafmt: unknown locale " + tag)
+		}
+		for i, u := range l.Units.Units() {
+			names = append(names, humanName{u.Singular, unitLengths[i]}, humanName{u.Plural, unitLengths[i]})
+		}
+	}
+	for i, u := range unitsShort {
+		names = append(names, humanName{u, unitLengths[i]})
+	}
+	names = append(names, humanName{"us", time.Microsecond}, humanName{"μs", time.Microsecond})
+	sortHumanNames(names)
+	return names, nil
+}
+
+// systemNames returns the singular, plural and short names of the units of s,
+// longest names first.
+func systemNames(s UnitSystem) []humanName {
+	var names []humanName
+	for _, u := range s {
+		names = append(names, humanName{u.Singular, u.Length}, humanName{u.Plural, u.Length}, humanName{u.Short, u.Length})
+	}
+	sortHumanNames(names)
+	return names
+}
+
+// sortHumanNames sorts names longest first, so the longest name matches.
+func sortHumanNames(names []humanName) {
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(names[i].name) > len(names[j].name)
+	})
+}
+
+// ParseHuman creates a new *Durafmt struct from a human readable string such
+// as `"2 weeks 18 hours 22 minutes"`, `"1 h 5 m"` or `"1 minute and 30 seconds"`.
+// Unit names are matched case insensitively against the locales with tags, or
+// against all registered locales if no tag is given.
+// Values may have a fractional part (`"1.5 hours"`) and the whole input may
+// have a sign in any SignStyle, `"-1 minute"`, `"1 minute ago"` or `"(1 minute)"`.
+// returns an error if input is invalid.
+func ParseHuman(input string, tags ...string) (*Durafmt, error) {
+	duration, err := parseHuman(input, tags)
+	if err != nil {
+		return nil, err
+	}
+	return &Durafmt{duration: duration, input: duration.String()}, nil
+}
+
+func parseHuman(input string, tags []string) (time.Duration, error) {
+	names, err := humanNames(tags)
+	if err != nil {
+		return 0, err
+	}
+	return parseNames(input, names)
+}
+
+// parseNames parses input like ParseHuman, with the unit names names.
+func parseNames(input string, names []humanName) (time.Duration, error) {
+	invalid := errors.New("durafmt: invalid duration " + strconv.Quote(input))
+
+	s := strings.TrimSpace(input)
+	neg := false
+	switch {
+	case s != "" && (s[0] == '-' || s[0] == '+'):
+		neg = s[0] == '-'
+		s = s[1:]
+	case len(s) > 5 && strings.EqualFold(s[:6], "minus "):
+		neg = true
+		s = s[6:]
+	case len(s) > 4 && strings.EqualFold(s[len(s)-4:], " ago"):
+		neg = true
+		s = s[:len(s)-4]
+	case len(s) > 1 && s[0] == '(' && s[len(s)-1] == ')':
+		neg = true
+		s = s[1 : len(s)-1]
+	}
+
+	// The magnitude of a negative duration may be one more than a positive one.
+	max := uint64(1<<63 - 1)
+	if neg {
+		max++
+	}
+
+	var total uint64
+	components := 0
+	for {
+		s = skipHumanSeparators(s)
+		if s == "" {
+			break
+		}
+
+		// Value: digits, optionally followed by a fraction.
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		whole := s[:i]
+		frac := ""
+		if i < len(s) && s[i] == '.' {
+			j := i + 1
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			frac = s[i+1 : j]
+			i = j
+		}
+		if whole == "" && frac == "" {
+			return 0, invalid
+		}
+		s = strings.TrimLeft(s[i:], " \t")
+
+		// Unit: the longest name followed by a boundary.
+		var unit time.Duration
+		for _, n := range names {
+			if len(s) < len(n.name) || !strings.EqualFold(s[:len(n.name)], n.name) {
+				continue
+			}
+			if rest := s[len(n.name):]; rest != "" {
+				r, _ := utf8.DecodeRuneInString(rest)
+				if r != ' ' && r != '\t' && r != ',' && (r < '0' || r > '9') {
+					continue
+				}
+			}
+			unit = n.length
+			s = s[len(n.name):]
+			break
+		}
+		if unit == 0 {
+			return 0, invalid
+		}
+
+		v, ok := humanValue(whole, frac, unit)
+		if !ok || v > max-total {
+			return 0, errors.New("durafmt: invalid duration " + strconv.Quote(input) + ": overflow")
+		}
+		total += v
+		components++
+	}
+	if components == 0 {
+		return 0, invalid
+	}
+	if neg {
+		return time.Duration(-total), nil
+	}
+	return time.Duration(total), nil
+}
+
+// skipHumanSeparators skips the spaces, commas and "and" words between components.
+func skipHumanSeparators(s string) string {
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		if len(s) >= 3 && strings.EqualFold(s[:3], "and") && (len(s) == 3 || s[3] == ' ' || s[3] == '\t') {
+			s = s[3:]
+			continue
+		}
+		return s
+	}
+}
+
+// humanValue returns whole.frac units in nanoseconds, false on overflow.
+func humanValue(whole, frac string, unit time.Duration) (uint64, bool) {
+	var v uint64
+	if whole != "" {
+		w, err := strconv.ParseUint(whole, 10, 64)
+		if err != nil || w > (1<<63)/uint64(unit) {
+			return 0, false
+		}
+		v = w * uint64(unit)
+	}
+	if frac != "" {
+		f, err := strconv.ParseFloat("0."+frac, 64)
+		if err != nil {
+			return 0, false
+		}
+		v += uint64(f * float64(unit))
+	}
+	return v, true
+}
@@ -0,0 +1,130 @@
+package durafmt
+
+import (
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// TestParseHuman for durafmt human readable string conversion.
+func TestParseHuman(t *testing.T) {
+	testStrings := []struct {
+		test     string
+		expected time.Duration
+	}{
+		{"1 second", time.Second},
+		{"2 minutes 30 seconds", 2*time.Minute + 30*time.Second},
+		{"2 weeks 18 hours 22 minutes 3 seconds", 354*time.Hour + 22*time.Minute + 3*time.Second},
+		{"1 year 52 weeks 23 hours", 17519 * time.Hour},
+		{"1 minute and 30 seconds", 90 * time.Second},
+		{"1 minute, 30 seconds", 90 * time.Second},
+		{"1 h 5 m", 65 * time.Minute},
+		{"1ms 1µs", 1001 * time.Microsecond},
+		{"5us", 5 * time.Microsecond},
+		{"1.5 hours", 90 * time.Minute},
+		{"2Minutes", 2 * time.Minute},
+		{"-1 minute 40 seconds", -100 * time.Second},
+		{"+3 Days", 72 * time.Hour},
+		{"minus 1 minute 40 seconds", -100 * time.Second},
+		{"1 minute 40 seconds ago", -100 * time.Second},
+		{"(1 minute 40 seconds)", -100 * time.Second},
+		{"0 seconds", 0},
+		{"2 semanas 18 horas", 354 * time.Hour},
+		{"3 Stunden", 3 * time.Hour},
+		{"1 año", 8760 * time.Hour},
+	}
+
+	for _, table := range testStrings {
+		d, err := ParseHuman(table.test)
+		if err != nil {
+			t.Errorf("ParseHuman(%q): %v", table.test, err)
+			continue
+		}
+		if d.Duration() != table.expected {
+			t.Errorf("ParseHuman(%q).Duration() = %v, expected %v",
+				table.test, d.Duration(), table.expected)
+		}
+	}
+}
+
+// TestParseHumanRoundTrip for parsing the output of Parse.
+func TestParseHumanRoundTrip(t *testing.T) {
+	for _, test := range []time.Duration{
+		time.Microsecond,
+		1001001 * time.Microsecond,
+		354*time.Hour + 22*time.Minute + 3*time.Second,
+		201479 * time.Hour,
+		-100 * time.Second,
+	} {
+		s := Parse(test).String()
+		d, err := ParseHuman(s)
+		if err != nil {
+			t.Errorf("ParseHuman(%q): %v", s, err)
+			continue
+		}
+		if d.Duration() != test {
+			t.Errorf("ParseHuman(%q).Duration() = %v, expected %v", s, d.Duration(), test)
+		}
+	}
+}
+
+// TestParseHumanInvalid for invalid inputs.
+func TestParseHumanInvalid(t *testing.T) {
+	for _, test := range []string{
+		"",
+		"-",
+		"seconds",
+		"1",
+		"1 fortnight",
+		"1 mo",
+		"and",
+		"1 second 2",
+		"300000 years",
+		"9223372037 seconds",
+	} {
+		if _, err := ParseHuman(test); err == nil {
+			t.Errorf("ParseHuman(%q). got nil error, expected an error", test)
+		}
+	}
+
+	if _, err := ParseHuman("1 hour", "xx"); err == nil {
+		t.Errorf("ParseHuman with unknown locale. got nil error, expected an error")
+	}
+	if _, err := ParseHuman("1 hora", "en"); err == nil {
+		t.Errorf("ParseHuman(%q, %q). got nil error, expected an error", "1 hora", "en")
+	}
+}
+
+// TestRoundTripProperty for parsing the output of Parse with limits, which
+// must give back the duration at the displayed precision.
+func TestRoundTripProperty(t *testing.T) {
+	property := func(n int64, limitN uint8) bool {
+		d := time.Duration(n)
+		limit := int(limitN % 9)
+		s := Parse(d).LimitFirstN(limit).String()
+		h, err := ParseHuman(s, "en")
+		if err != nil {
+			t.Logf("ParseHuman(%q): %v", s, err)
+			return false
+		}
+
+		// The value of the components shown, from the largest unit down.
+		var expected uint64
+		shown := 0
+		for i, v := range Parse(d).components(DefaultUnitSystem) {
+			if v == 0 || (limit > 0 && shown == limit) {
+				continue
+			}
+			expected += v * uint64(unitLengths[i])
+			shown++
+		}
+		if magnitude(h.Duration()) != expected {
+			t.Logf("ParseHuman(%q).Duration() = %v, expected %v", s, h.Duration(), time.Duration(expected))
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 5000}); err != nil {
+		t.Error(err)
+	}
+}
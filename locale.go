@@ -0,0 +1,87 @@
+package durafmt
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Locale a named set of units used to format and parse durations.
+type Locale struct {
+	// Tag language tag of the locale, e.g. `"en"` or `"pt-BR"`.
+	Tag string
+	// Units the duration units of the locale.
+	Units Units
+	// Rates the phrases of intervals and rates of the locale, english if zero.
+	Rates Rates
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{}
+)
+
+func init() {
+	for _, l := range []struct {
+		tag, units string
+		rates      Rates
+	}{
+		{"en", "year,week,day,hour,minute,second,millisecond,microsecond", DefaultRates},
+		{"pt", "ano,semana,dia,hora,minuto,segundo,milissegundo,microssegundo", Rates{
+			"a cada %s", "a cada %s", "%s por %s",
+			[8]string{"anualmente", "semanalmente", "diariamente"},
+		}},
+		{"es", "año:años,semana,día,hora,minuto,segundo,milisegundo,microsegundo", Rates{
+			"cada %s", "cada %s", "%s por %s",
+			[8]string{"anualmente", "semanalmente", "diariamente"},
+		}},
+		{"fr", "an,semaine,jour,heure,minute,seconde,milliseconde,microseconde", Rates{
+			"toutes les %s", "chaque %s", "%s par %s",
+			[8]string{"annuellement", "", "quotidiennement"},
+		}},
+		{"de", "Jahr:Jahre,Woche:Wochen,Tag:Tage,Stunde:Stunden,Minute:Minuten,Sekunde:Sekunden,Millisekunde:Millisekunden,Mikrosekunde:Mikrosekunden", Rates{
+			"alle %s", "jede %s", "%s pro %s",
+			[8]string{"jährlich", "wöchentlich", "täglich", "stündlich", "minütlich", "sekündlich"},
+		}},
+	} {
+		u, err := DefaultUnitsCoder.Decode(l.units)
+		if err != nil {
+			panic("durafmt: bad builtin locale " + l.tag + ": " + err.Error())
+		}
+		RegisterLocale(Locale{Tag: l.tag, Units: u, Rates: l.rates})
+	}
+}
+
+// normalizeTag lower cases tag and uses `"-"` as subtag separator.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(tag), "_", "-", -1))
+}
+
+// RegisterLocale registers l, replacing any locale with the same tag.
+// Tags are matched case insensitively, `"pt_BR"` and `"pt-br"` are the same tag.
+func RegisterLocale(l Locale) {
+	l.Tag = normalizeTag(l.Tag)
+	localesMu.Lock()
+	locales[l.Tag] = l
+	localesMu.Unlock()
+}
+
+// LookupLocale returns the locale registered with tag.
+func LookupLocale(tag string) (Locale, bool) {
+	localesMu.RLock()
+	l, ok := locales[normalizeTag(tag)]
+	localesMu.RUnlock()
+	return l, ok
+}
+
+// Locales returns the sorted tags of all registered locales.
+func Locales() []string {
+	localesMu.RLock()
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	localesMu.RUnlock()
+	sort.Strings(tags)
+	return tags
+}
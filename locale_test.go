@@ -0,0 +1,36 @@
+package durafmt
+
+import (
+	"testing"
+)
+
+func TestLocales(t *testing.T) {
+	for _, tag := range []string{"en", "pt", "es", "fr", "de"} {
+		if _, ok := LookupLocale(tag); !ok {
+			t.Errorf("LookupLocale(%q) not found", tag)
+		}
+	}
+
+	u, err := DefaultUnitsCoder.Decode("jaar:jaren,week:weken,dag:dagen,uur:uur,minuut:minuten,seconde:seconden,milliseconde:milliseconden,microseconde:microseconden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	RegisterLocale(Locale{Tag: "nl_NL", Units: u})
+	l, ok := LookupLocale("NL-nl")
+	if !ok {
+		t.Fatalf("LookupLocale(%q) not found", "NL-nl")
+	}
+	if l.Tag != "nl-nl" || l.Units.Hour.Plural != "uur" {
+		t.Errorf("LookupLocale(%q) = %v", "NL-nl", l)
+	}
+
+	found := false
+	for _, tag := range Locales() {
+		if tag == "nl-nl" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Locales() = %v, expected nl-nl", Locales())
+	}
+}
@@ -0,0 +1,84 @@
+package durafmt
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseAcceptLanguage returns the language tags of an Accept-Language header,
+// highest quality first, e.g. "fr-CH, fr;q=0.9, en;q=0.8" is
+// `[]string{"fr-CH", "fr", "en"}`. Tags with a quality of 0 or an invalid quality are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var langs []weighted
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if len(param) > 2 && (param[0] == 'q' || param[0] == 'Q') && param[1] == '=' {
+				v, err := strconv.ParseFloat(param[2:], 64)
+				if err != nil || v < 0 || v > 1 {
+					v = 0
+				}
+				q = v
+			}
+		}
+		if q > 0 {
+			langs = append(langs, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(langs, func(i, j int) bool {
+		return langs[i].q > langs[j].q
+	})
+
+	tags := make([]string, len(langs))
+	for i, l := range langs {
+		tags[i] = l.tag
+	}
+	return tags
+}
+
+// MatchLocale returns the tag of the registered locale best matching the
+// Accept-Language header, or fallback if none does.
+// Each language is matched exactly ("pt-br"), then by its base language ("pt"),
+// then against the regional locales of its base language ("pt-pt"); "*" matches fallback.
+func MatchLocale(header, fallback string) string {
+	tags := Locales()
+	for _, lang := range ParseAcceptLanguage(header) {
+		lang = normalizeTag(lang)
+		if lang == "*" {
+			return fallback
+		}
+		if _, ok := LookupLocale(lang); ok {
+			return lang
+		}
+		base := lang
+		if i := strings.IndexByte(lang, '-'); i >= 0 {
+			base = lang[:i]
+		}
+		if _, ok := LookupLocale(base); ok {
+			return base
+		}
+		for _, tag := range tags {
+			if strings.HasPrefix(tag, base+"-") {
+				return tag
+			}
+		}
+	}
+	return fallback
+}
+
+// NegotiateFormatter returns a Formatter with opts and the units of the locale
+// best matching the Accept-Language header, english if none does.
+func NegotiateFormatter(header string, opts ...Option) Formatter {
+	return NewFormatter(opts...).With(WithLocale(MatchLocale(header, "en")))
+}
@@ -0,0 +1,50 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected []string
+	}{
+		{"", []string{}},
+		{"de", []string{"de"}},
+		{"fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5", []string{"fr-CH", "fr", "en", "de", "*"}},
+		{"en;q=0.5, pt-BR, es;Q=0.8", []string{"pt-BR", "es", "en"}},
+		{"en;q=0, de;q=abc, fr ; q=0.3, , it;q=2", []string{"fr"}},
+	}
+	for _, tt := range tests {
+		if got := ParseAcceptLanguage(tt.header); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("ParseAcceptLanguage(%q) = %q, expected %q", tt.header, got, tt.expected)
+		}
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	units, err := DefaultUnitsCoder.Decode("año:años,semana,día,hora,minuto,segundo,milisegundo,microsegundo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	RegisterLocale(Locale{Tag: "es-MX", Units: units})
+
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{"", "en"},
+		{"pt-BR,pt;q=0.9", "pt"},
+		{"DE-at", "de"},
+		{"es-MX, es;q=0.9", "es-mx"},
+		{"it, ja;q=0.8", "en"},
+		{"it, *;q=0.1, fr;q=0.05", "en"},
+		{"it, fr;q=0.5", "fr"},
+	}
+	for _, tt := range tests {
+		if got := MatchLocale(tt.header, "en"); got != tt.expected {
+			t.Errorf("MatchLocale(%q) = %q, expected %q", tt.header, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package durafmt
+
+import "time"
+
+// Layout the layout of the output of Durafmt.String.
+type Layout int
+
+const (
+	// LayoutLong units by name, "2 weeks 18 hours". The default.
+	LayoutLong Layout = iota
+	// LayoutInternational international units, "2 w 18 h".
+	LayoutInternational
+	// LayoutClock a clock, "354:22:03".
+	LayoutClock
+)
+
+// config the output format of a Durafmt or Formatter.
+type config struct {
+	units      *Units        // Non-nil to use instead of the default units.
+	layout     Layout        // Layout of String.
+	limitN     int           // Non-zero to limit only first N elements to output.
+	limitUnit  string        // Non-empty to limit max unit
+	round      time.Duration // Non-zero to round the duration to a multiple of round.
+	separators *[2]string    // Non-nil to separate values from units and elements.
+	sign       SignStyle
+	decimals   *int          // Non-nil to output a single unit with decimals.
+	day        time.Duration // Non-zero the length of a day, no weeks and years are output.
+	system     UnitSystem    // Non-nil to use instead of the units.
+	rates      *Rates        // Non-nil to describe intervals and rates instead of DefaultRates.
+}
+
+// Option sets an output format option of New or NewFormatter.
+type Option func(*config)
+
+// WithUnits sets the units of the output.
+func WithUnits(units Units) Option {
+	return func(c *config) {
+		c.units = &units
+	}
+}
+
+// WithUnitSystem sets the units of the output to the units of s, like
+// FormatSystem. It takes precedence over WithUnits and WithLocale.
+func WithUnitSystem(s UnitSystem) Option {
+	return func(c *config) {
+		c.system = s
+	}
+}
+
+// WithLocale sets the units of the output, and the phrases of Every and Rate,
+// to the ones of the locale registered with tag.
+// Unknown tags leave the units unchanged.
+func WithLocale(tag string) Option {
+	l, ok := LookupLocale(tag)
+	return func(c *config) {
+		if ok {
+			c.units = &l.Units
+			c.rates = &l.Rates
+		}
+	}
+}
+
+// WithLayout sets the layout of the output.
+func WithLayout(layout Layout) Option {
+	return func(c *config) {
+		c.layout = layout
+	}
+}
+
+// WithLimitFirstN outputs only the first n elements, like LimitFirstN.
+func WithLimitFirstN(n int) Option {
+	return func(c *config) {
+		c.limitN = n
+	}
+}
+
+// WithLimitToUnit outputs no unit bigger than unit, like LimitToUnit.
+func WithLimitToUnit(unit string) Option {
+	return func(c *config) {
+		c.limitUnit = unit
+	}
+}
+
+// WithRounding rounds durations to the nearest multiple of unit before output,
+// e.g. `WithRounding(time.Second)` outputs 1.6s as "2 seconds". unit <= 0 means no rounding.
+func WithRounding(unit time.Duration) Option {
+	return func(c *config) {
+		c.round = unit
+	}
+}
+
+// WithSeparators sets the separator between values and units, " " by default,
+// and the separator between elements, " " by default, e.g.
+// `WithSeparators(" ", ", ")` outputs "2 weeks, 18 hours".
+func WithSeparators(value, elements string) Option {
+	return func(c *config) {
+		c.separators = &[2]string{value, elements}
+	}
+}
+
+// WithSign outputs the sign of durations with style, like Sign.
+func WithSign(style SignStyle) Option {
+	return func(c *config) {
+		c.sign = style
+	}
+}
+
+// WithDecimals outputs durations as a decimal number of a single unit with n
+// decimals, e.g. `WithDecimals(1)` outputs 90 minutes as "1.5 hours".
+// The unit is the limit unit of WithLimitToUnit if set, otherwise the largest
+// unit not longer than the duration. n < 0 outputs all the units, the default.
+func WithDecimals(n int) Option {
+	return func(c *config) {
+		if n < 0 {
+			c.decimals = nil
+			return
+		}
+		c.decimals = &n
+	}
+}
+
+// WithDayLength counts days of length day instead of 24 hours, e.g.
+// `WithDayLength(8 * time.Hour)` outputs 10 working hours as "1 day 2 hours".
+// Weeks and years are not output. day < time.Microsecond means 24 hours.
+// It applies to the default units and to the units of WithUnits and WithLocale.
+func WithDayLength(day time.Duration) Option {
+	return func(c *config) {
+		if day < time.Microsecond {
+			day = 0
+		}
+		c.day = day
+	}
+}
+
+func newConfig(opts []Option) config {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// New creates a new *Durafmt struct with the output format set by opts.
+func New(dinput time.Duration, opts ...Option) *Durafmt {
+	return &Durafmt{duration: dinput, input: dinput.String(), config: newConfig(opts)}
+}
+
+// Formatter formats durations with a fixed output format.
+// Formatters are immutable, safe for concurrent use, and cheap to copy and reuse.
+// The zero Formatter formats like `Parse(d).String()`.
+type Formatter struct {
+	config config
+}
+
+// NewFormatter creates a new Formatter with the output format set by opts.
+func NewFormatter(opts ...Option) Formatter {
+	return Formatter{newConfig(opts)}
+}
+
+// With returns a copy of f with opts applied.
+func (f Formatter) With(opts ...Option) Formatter {
+	c := f.config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return Formatter{c}
+}
+
+// Format formats d, it can be used as a Style: `Style(f.Format)`.
+func (f Formatter) Format(d time.Duration) string {
+	dur := Durafmt{duration: d, config: f.config}
+	return dur.String()
+}
+
+// Parse creates a new *Durafmt struct with the output format of f.
+func (f Formatter) Parse(d time.Duration) *Durafmt {
+	return &Durafmt{duration: d, input: d.String(), config: f.config}
+}
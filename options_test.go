@@ -0,0 +1,119 @@
+package durafmt
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	d := 354*time.Hour + 22*time.Minute + 3*time.Second + 600*time.Millisecond
+	tests := []struct {
+		opts     []Option
+		expected string
+	}{
+		{nil, "2 weeks 18 hours 22 minutes 3 seconds 600 milliseconds"},
+		{[]Option{WithLimitFirstN(2)}, "2 weeks 18 hours"},
+		{[]Option{WithLimitToUnit("days")}, "14 days 18 hours 22 minutes 3 seconds 600 milliseconds"},
+		{[]Option{WithRounding(time.Second)}, "2 weeks 18 hours 22 minutes 4 seconds"},
+		{[]Option{WithRounding(time.Hour), WithLimitToUnit("hours")}, "354 hours"},
+		{[]Option{WithLocale("pt"), WithLimitFirstN(3)}, "2 semanas 18 horas 22 minutos"},
+		{[]Option{WithLocale("xx"), WithLimitFirstN(1)}, "2 weeks"},
+		{[]Option{WithLayout(LayoutInternational), WithLimitFirstN(2)}, "2 w 18 h"},
+		{[]Option{WithLayout(LayoutClock)}, "354:22:03"},
+		{[]Option{WithSeparators("", ""), WithLayout(LayoutInternational), WithRounding(time.Second)}, "2w18h22m4s"},
+		{[]Option{WithSeparators(" ", ", "), WithLimitFirstN(3)}, "2 weeks, 18 hours, 22 minutes"},
+		{[]Option{WithDecimals(1)}, "2.1 weeks"},
+		{[]Option{WithDecimals(2), WithLimitToUnit("hours")}, "354.37 hours"},
+		{[]Option{WithDecimals(0), WithLimitToUnit("days"), WithLayout(LayoutInternational)}, "15 d"},
+		{[]Option{WithDecimals(1), WithDecimals(-1), WithLimitFirstN(1)}, "2 weeks"},
+		{[]Option{WithDayLength(8 * time.Hour), WithLimitFirstN(2)}, "44 days 2 hours"},
+		{[]Option{WithDayLength(8 * time.Hour), WithLimitToUnit("years"), WithDecimals(1)}, "44.3 days"},
+		{[]Option{WithDayLength(8 * time.Hour), WithLimitToUnit("hours"), WithLimitFirstN(1)}, "354 hours"},
+		{[]Option{WithDayLength(8 * time.Hour), WithDayLength(0), WithLimitFirstN(1)}, "2 weeks"},
+	}
+
+	for _, tt := range tests {
+		if got := New(d, tt.opts...).String(); got != tt.expected {
+			t.Errorf("New(%v).String() = %q, expected %q", d, got, tt.expected)
+		}
+		if got := NewFormatter(tt.opts...).Format(d); got != tt.expected {
+			t.Errorf("NewFormatter().Format(%v) = %q, expected %q", d, got, tt.expected)
+		}
+	}
+
+	if got := New(-1600*time.Millisecond, WithRounding(time.Second), WithSign(SignAgo)).String(); got != "2 seconds ago" {
+		t.Errorf("New() with rounding and sign = %q", got)
+	}
+	if got := New(-400*time.Millisecond, WithRounding(time.Second)).String(); got != "0 seconds" {
+		t.Errorf("New() rounded to zero = %q", got)
+	}
+	if got := New(-90*time.Minute, WithDecimals(1)).String(); got != "-1.5 hours" {
+		t.Errorf("New() with decimals = %q", got)
+	}
+	if got := New(time.Hour, WithDecimals(0)).String(); got != "1 hour" {
+		t.Errorf("New() with decimals, singular = %q", got)
+	}
+	if got := New(-time.Millisecond, WithDecimals(1), WithLimitToUnit("hours")).String(); got != "0.0 hours" {
+		t.Errorf("New() with decimals rounded to zero = %q", got)
+	}
+	// Chained setters still apply on top of the options.
+	if got := New(d, WithLocale("pt")).LimitFirstN(1).String(); got != "2 semanas" {
+		t.Errorf("New().LimitFirstN(1) = %q", got)
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	var zero Formatter
+	if got := zero.Format(-100 * time.Second); got != "-1 minute 40 seconds" {
+		t.Errorf("Formatter{}.Format() = %q", got)
+	}
+	if got := zero.Format(0); got != "0 seconds" {
+		t.Errorf("Formatter{}.Format(0) = %q", got)
+	}
+
+	short := NewFormatter(WithLimitFirstN(1))
+	intl := short.With(WithLayout(LayoutInternational))
+	if got := short.Format(100 * time.Second); got != "1 minute" {
+		t.Errorf("short.Format() = %q", got)
+	}
+	if got := intl.Format(100 * time.Second); got != "1 m" {
+		t.Errorf("intl.Format() = %q", got)
+	}
+	if got := intl.Parse(100 * time.Second).LimitFirstN(2).String(); got != "1 m 40 s" {
+		t.Errorf("intl.Parse().LimitFirstN(2) = %q", got)
+	}
+	if got := short.Format(100 * time.Second); got != "1 minute" {
+		t.Errorf("short.Format() after With() and Parse() = %q", got)
+	}
+
+	var style Style = intl.Format
+	if got := style(time.Hour); got != "1 h" {
+		t.Errorf("Style(intl.Format) = %q", got)
+	}
+}
+
+func TestFormatter_Concurrent(t *testing.T) {
+	f := NewFormatter(WithLocale("de"), WithLimitFirstN(2), WithSeparators(" ", ", "))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if got := f.Format(25 * time.Hour); got != "1 Tag, 1 Stunde" {
+					t.Errorf("Format() = %q", got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkFormatter(b *testing.B) {
+	f := NewFormatter(WithLimitFirstN(2))
+	for n := 1; n < b.N; n++ {
+		f.Format(time.Duration(n) * time.Second)
+	}
+}
@@ -0,0 +1,224 @@
+package durafmt
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultProgressSmoothing default weight of the latest throughput sample in
+// the exponentially weighted moving average of a Progress.
+const DefaultProgressSmoothing = 0.3
+
+// Progress estimates the remaining time of a process from the number of items done
+// over time, e.g. "about 3 minutes remaining". It is safe for concurrent use.
+type Progress struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	total     int64
+	smoothing float64
+	start     time.Time
+	lastTime  time.Time
+	lastDone  int64
+	done      int64
+	rate      float64 // smoothed items per second, 0 until known.
+}
+
+// NewProgress creates a new *Progress of total items, started now.
+func NewProgress(total int64) *Progress {
+	p := &Progress{now: time.Now, total: total, smoothing: DefaultProgressSmoothing}
+	p.start = p.now()
+	p.lastTime = p.start
+	return p
+}
+
+// Smoothing sets the weight, between 0 and 1, of the latest throughput sample.
+// Lower values give steadier but slower reacting estimates.
+func (p *Progress) Smoothing(alpha float64) *Progress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if alpha > 0 && alpha <= 1 {
+		p.smoothing = alpha
+	}
+	return p
+}
+
+// Update sets the number of items done.
+func (p *Progress) Update(done int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(done)
+}
+
+// Add adds n to the number of items done.
+func (p *Progress) Add(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(p.done + n)
+}
+
+func (p *Progress) update(done int64) {
+	p.done = done
+	now := p.now()
+	dt := now.Sub(p.lastTime)
+	delta := done - p.lastDone
+	// Samples less than a millisecond apart are accumulated into the next one.
+	if dt < time.Millisecond || delta <= 0 {
+		return
+	}
+	sample := float64(delta) / dt.Seconds()
+	if p.rate == 0 {
+		p.rate = sample
+	} else {
+		p.rate = p.smoothing*sample + (1-p.smoothing)*p.rate
+	}
+	p.lastTime = now
+	p.lastDone = done
+}
+
+// Done returns the number of items done.
+func (p *Progress) Done() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.done
+}
+
+// Fraction returns the fraction of the items done, between 0 and 1.
+func (p *Progress) Fraction() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.fraction()
+}
+
+func (p *Progress) fraction() float64 {
+	if p.total <= 0 || p.done >= p.total {
+		return 1
+	}
+	if p.done <= 0 {
+		return 0
+	}
+	return float64(p.done) / float64(p.total)
+}
+
+// Rate returns the smoothed throughput in items per second, 0 until known.
+func (p *Progress) Rate() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.rate
+}
+
+// Elapsed returns the time since p was created.
+func (p *Progress) Elapsed() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.now().Sub(p.start)
+}
+
+// Remaining returns the estimated time until all items are done,
+// false if it is not known yet. It counts down between updates, and grows
+// when no item is done by the time the next one is expected.
+func (p *Progress) Remaining() (time.Duration, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.remaining()
+}
+
+func (p *Progress) remaining() (time.Duration, bool) {
+	if p.done >= p.total {
+		return 0, true
+	}
+	if p.rate == 0 {
+		return 0, false
+	}
+	rate := p.rate
+	stall := p.now().Sub(p.lastTime)
+	// Time spent since the last sample counts towards the estimate, until the
+	// next item was expected. After that, the stall is folded into the rate
+	// as a sample for each item expected since, and the estimate grows.
+	if missed := stall.Seconds()*rate - 1; missed > 0 {
+		sample := float64(p.done-p.lastDone+1) / stall.Seconds()
+		keep := math.Pow(1-p.smoothing, missed)
+		rate = keep*rate + (1-keep)*sample
+		stall = 0
+	}
+	seconds := float64(p.total-p.done) / rate
+	if seconds > float64(1<<63-1)/float64(time.Second) {
+		return 1<<63 - 1, true
+	}
+	return time.Duration(seconds*float64(time.Second)) - stall, true
+}
+
+// ETA returns the estimated time all items are done, false if it is not known yet.
+func (p *Progress) ETA() (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	rem, ok := p.remaining()
+	if !ok {
+		return time.Time{}, false
+	}
+	return p.now().Add(rem), true
+}
+
+// precision returns the number of elements to output for the remaining time,
+// more as the process nears completion.
+func (p *Progress) precision() int {
+	switch f := p.fraction(); {
+	case f < 0.5:
+		return 1
+	case f < 0.9:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// progressRound rounds d to the second, unless it is less than a second.
+func progressRound(d time.Duration) time.Duration {
+	if d < time.Second {
+		return d
+	}
+	return d.Round(time.Second)
+}
+
+// ElapsedString formats the elapsed time, e.g. "2 minutes 4 seconds".
+func (p *Progress) ElapsedString() string {
+	return Parse(progressRound(p.Elapsed())).LimitFirstN(2).String()
+}
+
+// RemainingString formats the remaining time, "" if it is not known yet.
+// The number of elements output grows as the process nears completion,
+// "3 minutes" at the start and "2 minutes 50 seconds" towards the end.
+func (p *Progress) RemainingString() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	rem, ok := p.remaining()
+	if !ok {
+		return ""
+	}
+	return Parse(progressRound(rem)).LimitFirstN(p.precision()).String()
+}
+
+// ETAString formats the estimated completion time with layout, "" if it is not known yet.
+func (p *Progress) ETAString(layout string) string {
+	eta, ok := p.ETA()
+	if !ok {
+		return ""
+	}
+	return eta.Format(layout)
+}
+
+// String formats the progress, e.g. "30 of 100 (30%), 1 minute elapsed, about 2 minutes remaining".
+func (p *Progress) String() string {
+	p.mu.Lock()
+	done, total := p.done, p.total
+	percent := int(p.fraction() * 100)
+	p.mu.Unlock()
+
+	s := strconv.FormatInt(done, 10) + " of " + strconv.FormatInt(total, 10) +
+		" (" + strconv.Itoa(percent) + "%), " + p.ElapsedString() + " elapsed"
+	if rem := p.RemainingString(); rem != "" {
+		s += ", about " + rem + " remaining"
+	}
+	return s
+}
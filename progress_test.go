@@ -0,0 +1,136 @@
+package durafmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func newTestProgress(total int64, clock *fakeClock) *Progress {
+	p := NewProgress(total)
+	p.now = clock.Now
+	p.start = clock.Now()
+	p.lastTime = p.start
+	return p
+}
+
+func TestProgress(t *testing.T) {
+	clock := newFakeClock()
+	p := newTestProgress(100, clock)
+
+	if _, ok := p.Remaining(); ok {
+		t.Errorf("Remaining() known before any progress")
+	}
+	if got := p.String(); got != "0 of 100 (0%), 0 seconds elapsed" {
+		t.Errorf("String() = %q", got)
+	}
+
+	// 1 item per second.
+	for i := 0; i < 10; i++ {
+		clock.Advance(time.Second)
+		p.Add(1)
+	}
+	if math.Abs(p.Rate()-1) > 1e-9 {
+		t.Errorf("Rate() = %v, expected 1", p.Rate())
+	}
+	if rem, ok := p.Remaining(); !ok || rem != 90*time.Second {
+		t.Errorf("Remaining() = %v, %v, expected 1m30s", rem, ok)
+	}
+	if got := p.String(); got != "10 of 100 (10%), 10 seconds elapsed, about 1 minute remaining" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := p.ETAString("15:04:05"); got != "15:05:45" {
+		t.Errorf("ETAString() = %q", got)
+	}
+
+	// Time since the last update counts towards the estimate.
+	clock.Advance(500 * time.Millisecond)
+	if rem, _ := p.Remaining(); rem != 89500*time.Millisecond {
+		t.Errorf("Remaining() = %v, expected 1m29.5s", rem)
+	}
+
+	p.Update(100)
+	if rem, ok := p.Remaining(); !ok || rem != 0 {
+		t.Errorf("Remaining() when done = %v, %v", rem, ok)
+	}
+	if p.Fraction() != 1 || p.Done() != 100 {
+		t.Errorf("Fraction() = %v, Done() = %v", p.Fraction(), p.Done())
+	}
+}
+
+func TestProgress_Precision(t *testing.T) {
+	tests := []struct {
+		done     int64
+		expected string
+	}{
+		{100, "2 hours"},
+		{6000, "1 hour 6 minutes"},
+		{9500, "8 minutes 20 seconds"},
+	}
+	for _, tt := range tests {
+		clock := newFakeClock()
+		p := newTestProgress(10000, clock)
+		clock.Advance(time.Duration(tt.done) * time.Second)
+		p.Update(tt.done)
+		if got := p.RemainingString(); got != tt.expected {
+			t.Errorf("RemainingString() at %d = %q, expected %q", tt.done, got, tt.expected)
+		}
+	}
+}
+
+func TestProgress_Smoothing(t *testing.T) {
+	clock := newFakeClock()
+	p := newTestProgress(1000, clock).Smoothing(0.5)
+
+	clock.Advance(time.Second)
+	p.Update(10) // 10/s
+	clock.Advance(time.Second)
+	p.Update(40) // 30/s
+	if math.Abs(p.Rate()-20) > 1e-9 {
+		t.Errorf("Rate() = %v, expected 20", p.Rate())
+	}
+
+	// A stall lowers the rate smoothly instead of jumping.
+	clock.Advance(10 * time.Second)
+	p.Update(50) // 1/s
+	if math.Abs(p.Rate()-10.5) > 1e-9 {
+		t.Errorf("Rate() = %v, expected 10.5", p.Rate())
+	}
+}
+
+func TestProgress_Stall(t *testing.T) {
+	clock := newFakeClock()
+	p := newTestProgress(100, clock)
+	clock.Advance(time.Second)
+	p.Update(10)
+	clock.Advance(time.Second)
+	p.Update(20) // 10/s, 8 seconds remaining.
+
+	// Counting down until the next item is expected.
+	clock.Advance(100 * time.Millisecond)
+	if rem, _ := p.Remaining(); rem != 7900*time.Millisecond {
+		t.Errorf("Remaining() = %v, expected 7.9s", rem)
+	}
+
+	// Past it, the estimate grows with the stall instead of reaching 0.
+	last := 8 * time.Second
+	for i := 0; i < 10; i++ {
+		clock.Advance(300 * time.Millisecond)
+		rem, ok := p.Remaining()
+		if !ok || rem <= last {
+			t.Fatalf("Remaining() after a %v stall = %v, %v, expected more than %v", clock.Now().Sub(p.lastTime), rem, ok, last)
+		}
+		last = rem
+	}
+	// 3.1 seconds without an item, 1 item every 3.1 seconds at best.
+	if got := p.RemainingString(); got != "4 minutes" {
+		t.Errorf("RemainingString() = %q", got)
+	}
+
+	// Progress resumes.
+	clock.Advance(100 * time.Millisecond)
+	p.Update(40)
+	if rem, _ := p.Remaining(); rem <= 0 || rem >= last {
+		t.Errorf("Remaining() after resuming = %v", rem)
+	}
+}
@@ -0,0 +1,99 @@
+package durafmt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rates the phrases describing intervals and rates, in fmt format with %s verbs.
+type Rates struct {
+	// Every describes an interval, with the duration, "every %s".
+	Every string
+	// EveryOne describes an interval of one unit, with the singular unit name, "every %s".
+	EveryOne string
+	// Per describes a rate, with the count and the singular unit name, "%s per %s".
+	Per string
+	// Once the adverbs of the intervals of one unit, in the order of `Units.Units()`,
+	// "hourly". Empty adverbs are described with EveryOne.
+	Once [8]string
+}
+
+// DefaultRates english Rates.
+var DefaultRates = Rates{
+	Every:    "every %s",
+	EveryOne: "every %s",
+	Per:      "%s per %s",
+	Once:     [8]string{"yearly", "weekly", "daily", "hourly"},
+}
+
+// ratePhrases returns the Rates of c, DefaultRates if unset or zero.
+func (c *config) ratePhrases() Rates {
+	if c.rates == nil || c.rates.Every == "" {
+		return DefaultRates
+	}
+	return *c.rates
+}
+
+// Every describes the interval d, like `NewFormatter().Every(d)`.
+func Every(d time.Duration) string {
+	return Formatter{}.Every(d)
+}
+
+// Every describes the interval d, "every 15 minutes", "every minute" or
+// "hourly", with the units of f and the phrases of its locale, see WithLocale.
+// Intervals of exactly one unit are described with the adverb of the unit, if
+// the locale has one, or else with the singular unit name.
+// returns "" if d <= 0.
+func (f Formatter) Every(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	r := f.config.ratePhrases()
+	s := f.config.unitSystem()
+	for i, u := range s {
+		if d != u.Length {
+			continue
+		}
+		if f.config.system == nil && f.config.day == 0 && r.Once[i] != "" {
+			return r.Once[i]
+		}
+		return fmt.Sprintf(r.EveryOne, u.Singular)
+	}
+	return fmt.Sprintf(r.Every, f.Format(d))
+}
+
+// Rate describes count events over d, like `NewFormatter().Rate(count, d)`.
+func Rate(count float64, d time.Duration) string {
+	return Formatter{}.Rate(count, d)
+}
+
+// Rate describes count events over d as a number of events per unit, "500 per
+// hour", with the units of f and the phrases of its locale, see WithLocale.
+// The unit is the largest unit not longer than d, and not shorter than a
+// second, or a larger unit if there is less than one event per unit, up to
+// the limit unit of WithLimitToUnit.
+// The number of events is rounded to one decimal.
+// returns "" if d <= 0.
+func (f Formatter) Rate(count float64, d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	r := f.config.ratePhrases()
+	s := f.config.unitSystem()
+	first := f.config.firstUnit(s)
+	i := f.config.commonUnit(s, d)
+	for i > first && s[i].Length < time.Second {
+		i--
+	}
+	perUnit := func(i int) float64 {
+		return count * float64(s[i].Length) / float64(d)
+	}
+	for i > first && count > 0 && perUnit(i) < 1 {
+		i--
+	}
+	n := strconv.FormatFloat(perUnit(i), 'f', 1, 64)
+	n = strings.TrimSuffix(n, ".0")
+	return fmt.Sprintf(r.Per, n, s[i].Singular)
+}
@@ -0,0 +1,90 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		opts     []Option
+		expected string
+	}{
+		{15 * time.Minute, nil, "every 15 minutes"},
+		{time.Minute, nil, "every minute"},
+		{time.Hour, nil, "hourly"},
+		{24 * time.Hour, nil, "daily"},
+		{7 * 24 * time.Hour, nil, "weekly"},
+		{time.Second, nil, "every second"},
+		{90 * time.Minute, nil, "every 1 hour 30 minutes"},
+		{90 * time.Minute, []Option{WithLimitToUnit("minutes")}, "every 90 minutes"},
+		{90 * time.Minute, []Option{WithLayout(LayoutInternational)}, "every 1 h 30 m"},
+		{15 * time.Minute, []Option{WithLocale("pt")}, "a cada 15 minutos"},
+		{time.Minute, []Option{WithLocale("pt")}, "a cada minuto"},
+		{24 * time.Hour, []Option{WithLocale("pt")}, "diariamente"},
+		{15 * time.Minute, []Option{WithLocale("de")}, "alle 15 Minuten"},
+		{time.Hour, []Option{WithLocale("de")}, "stündlich"},
+		{time.Millisecond, []Option{WithLocale("de")}, "jede Millisekunde"},
+		{time.Hour, []Option{WithLocale("fr")}, "chaque heure"},
+		{2 * time.Hour, []Option{WithLocale("fr")}, "toutes les 2 heures"},
+		{8 * time.Hour, []Option{WithDayLength(8 * time.Hour)}, "every day"},
+		{Sol, []Option{WithUnitSystem(MarsUnitSystem)}, "every sol"},
+		{0, nil, ""},
+		{-time.Minute, nil, ""},
+	}
+	for _, tt := range tests {
+		if got := NewFormatter(tt.opts...).Every(tt.d); got != tt.expected {
+			t.Errorf("Every(%v) = %q, expected %q", tt.d, got, tt.expected)
+		}
+	}
+	if got := Every(time.Hour); got != "hourly" {
+		t.Errorf("Every(1h) = %q", got)
+	}
+
+	// Locales without rates are english.
+	RegisterLocale(Locale{Tag: "x-rates", Units: units})
+	defer func() {
+		localesMu.Lock()
+		delete(locales, "x-rates")
+		localesMu.Unlock()
+	}()
+	if got := NewFormatter(WithLocale("x-rates")).Every(time.Hour); got != "hourly" {
+		t.Errorf("Every(1h) without rates = %q", got)
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		count    float64
+		d        time.Duration
+		opts     []Option
+		expected string
+	}{
+		{1000, 2 * time.Hour, nil, "500 per hour"},
+		{3, time.Hour, nil, "3 per hour"},
+		{1, time.Hour, nil, "1 per hour"},
+		{15, 90 * time.Second, nil, "10 per minute"},
+		{100, 10 * time.Millisecond, nil, "10000 per second"},
+		{1, 10 * 24 * time.Hour, nil, "36.5 per year"},
+		{1, 100 * 24 * time.Hour, nil, "3.6 per year"},
+		{1, 400 * 24 * time.Hour, nil, "0.9 per year"},
+		{3, 10 * 24 * time.Hour, nil, "2.1 per week"},
+		{10, 3 * time.Hour, nil, "3.3 per hour"},
+		{0, time.Hour, nil, "0 per hour"},
+		{1000, 2 * time.Hour, []Option{WithLimitToUnit("minutes")}, "8.3 per minute"},
+		{1000, 2 * time.Hour, []Option{WithLocale("pt")}, "500 por hora"},
+		{1000, 2 * time.Hour, []Option{WithLocale("de")}, "500 pro Stunde"},
+		{5, 3 * Sol, []Option{WithUnitSystem(MarsUnitSystem)}, "1.7 per sol"},
+		{5, 0, nil, ""},
+		{5, -time.Hour, nil, ""},
+	}
+	for _, tt := range tests {
+		if got := NewFormatter(tt.opts...).Rate(tt.count, tt.d); got != tt.expected {
+			t.Errorf("Rate(%v, %v) = %q, expected %q", tt.count, tt.d, got, tt.expected)
+		}
+	}
+	if got := Rate(1000, 2*time.Hour); got != "500 per hour" {
+		t.Errorf("Rate(1000, 2h) = %q", got)
+	}
+}
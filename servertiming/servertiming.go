@@ -0,0 +1,254 @@
+// Package servertiming provides net/http middleware timing requests and their
+// phases, reporting them in a Server-Timing header and a human readable access log.
+//
+//	handler := servertiming.Middleware(mux, servertiming.Options{Logger: log.Default()})
+//
+// Handlers time their phases with Start:
+//
+//	stop := servertiming.Start(r.Context(), "db")
+//	rows, err := db.Query(...)
+//	stop()
+//
+// and the response carries `Server-Timing: db;dur=12.3, total;dur=15.1`
+// while the access log reads "GET /items 200 in 15 milliseconds (db 12 milliseconds)".
+package servertiming
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hako/durafmt"
+)
+
+// Metric a Server-Timing metric.
+type Metric struct {
+	// Name of the metric, a token.
+	Name string
+	// Desc optional description of the metric.
+	Desc string
+	// Duration of the metric.
+	Duration time.Duration
+}
+
+// String formats m as a Server-Timing metric, `name;dur=12.3;desc="..."`.
+// Characters not allowed in a token are removed from the name.
+func (m Metric) String() string {
+	var b strings.Builder
+	b.WriteString(token(m.Name))
+	b.WriteString(";dur=")
+	b.WriteString(strconv.FormatFloat(float64(m.Duration)/float64(time.Millisecond), 'f', -1, 64))
+	if m.Desc != "" {
+		b.WriteString(`;desc="`)
+		for _, r := range m.Desc {
+			if r == '"' || r == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+// token removes the characters not allowed in an HTTP token from s.
+func token(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > ' ' && r < 0x7f && !strings.ContainsRune(`"(),/:;<=>?@[\]{}`, r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Header formats metrics as a Server-Timing header value.
+func Header(metrics []Metric) string {
+	parts := make([]string, len(metrics))
+	for i, m := range metrics {
+		parts[i] = m.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Timings the metrics of a request. It is safe for concurrent use.
+type Timings struct {
+	mu      sync.Mutex
+	metrics []Metric
+}
+
+// Add adds m to t.
+func (t *Timings) Add(m Metric) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.metrics = append(t.metrics, m)
+	t.mu.Unlock()
+}
+
+// Metrics returns the metrics added to t.
+func (t *Timings) Metrics() []Metric {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	metrics := make([]Metric, len(t.metrics))
+	copy(metrics, t.metrics)
+	return metrics
+}
+
+// timingsKey the context key of the Timings of a request.
+type timingsKey struct{}
+
+// NewContext returns a copy of ctx carrying t.
+func NewContext(ctx context.Context, t *Timings) context.Context {
+	return context.WithValue(ctx, timingsKey{}, t)
+}
+
+// FromContext returns the Timings carried by ctx, nil if there is none.
+// The methods of a nil *Timings do nothing.
+func FromContext(ctx context.Context) *Timings {
+	t, _ := ctx.Value(timingsKey{}).(*Timings)
+	return t
+}
+
+// Start starts timing the phase name of the request of ctx, and returns the
+// function ending it. Phases of requests not served by Middleware are not recorded.
+func Start(ctx context.Context, name string) func() {
+	return StartDesc(ctx, name, "")
+}
+
+// StartDesc is like Start, with a description of the phase.
+func StartDesc(ctx context.Context, name, desc string) func() {
+	t := FromContext(ctx)
+	start := time.Now()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.Add(Metric{Name: name, Desc: desc, Duration: time.Since(start)})
+		})
+	}
+}
+
+// Options configures Middleware.
+type Options struct {
+	// Logger logs a line per request, nothing if nil.
+	Logger *log.Logger
+	// Style formats the durations of the log,
+	// the first two elements of the default durafmt output if nil.
+	Style durafmt.Style
+	// Total name of the metric timing the whole request, "total" if empty.
+	Total string
+}
+
+// Middleware returns a handler timing the requests served by next and their
+// phases, see Start.
+//
+// The phases ended before the response header is written are reported in its
+// Server-Timing header, with the total time until then. All the phases and the
+// total time of the request are logged.
+func Middleware(next http.Handler, opts Options) http.Handler {
+	style := opts.Style
+	if style == nil {
+		style = durafmt.NewFormatter(durafmt.WithLimitFirstN(2)).Format
+	}
+	total := opts.Total
+	if total == "" {
+		total = "total"
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		timings := new(Timings)
+		tw := &timingWriter{ResponseWriter: w, timings: timings, start: start, total: total}
+		next.ServeHTTP(tw, r.WithContext(NewContext(r.Context(), timings)))
+		tw.writeTimings()
+		elapsed := time.Since(start)
+
+		if opts.Logger != nil {
+			line := r.Method + " " + r.URL.RequestURI() + " " + strconv.Itoa(tw.status) + " in " + style(elapsed)
+			var phases []string
+			for _, m := range timings.Metrics() {
+				phases = append(phases, m.Name+" "+style(m.Duration))
+			}
+			if len(phases) > 0 {
+				line += " (" + strings.Join(phases, ", ") + ")"
+			}
+			opts.Logger.Print(line)
+		}
+	})
+}
+
+// timingWriter adds the Server-Timing header before the response header is written.
+type timingWriter struct {
+	http.ResponseWriter
+	timings *Timings
+	start   time.Time
+	total   string
+	status  int
+}
+
+func (w *timingWriter) writeTimings() {
+	if w.status != 0 {
+		return
+	}
+	w.status = http.StatusOK
+	metrics := append(w.timings.Metrics(), Metric{Name: w.total, Duration: time.Since(w.start)})
+	w.Header().Add("Server-Timing", Header(metrics))
+}
+
+// WriteHeader records and writes status, unless the header was already written.
+// Informational statuses, like 103 Early Hints, are written without being recorded.
+func (w *timingWriter) WriteHeader(status int) {
+	if status >= 100 && status < 200 && status != http.StatusSwitchingProtocols {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if w.status != 0 {
+		return
+	}
+	w.writeTimings()
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *timingWriter) Write(b []byte) (int, error) {
+	w.writeTimings()
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher if the underlying ResponseWriter does.
+func (w *timingWriter) Flush() {
+	w.writeTimings()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker if the underlying ResponseWriter does.
+// The header of a hijacked connection is written by the handler, without
+// Server-Timing, and logged as 101 Switching Protocols.
+func (w *timingWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("servertiming: the ResponseWriter does not implement http.Hijacker")
+	}
+	conn, rw, err := h.Hijack()
+	if err == nil && w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return conn, rw, err
+}
+
+// Unwrap returns the underlying ResponseWriter, for http.ResponseController.
+func (w *timingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,194 @@
+package servertiming
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetric_String(t *testing.T) {
+	tests := []struct {
+		metric   Metric
+		expected string
+	}{
+		{Metric{Name: "db", Duration: 53200 * time.Microsecond}, "db;dur=53.2"},
+		{Metric{Name: "cache", Duration: 0}, "cache;dur=0"},
+		{Metric{Name: "app", Desc: `say "hi" \o/`, Duration: 2 * time.Second}, `app;dur=2000;desc="say \"hi\" \\o/"`},
+		{Metric{Name: "db query;x=1", Duration: time.Millisecond}, "dbqueryx1;dur=1"},
+	}
+	for _, tt := range tests {
+		if got := tt.metric.String(); got != tt.expected {
+			t.Errorf("String() = %q, expected %q", got, tt.expected)
+		}
+	}
+
+	if got := Header([]Metric{{Name: "a", Duration: time.Millisecond}, {Name: "b", Duration: 1500 * time.Microsecond}}); got != "a;dur=1, b;dur=1.5" {
+		t.Errorf("Header() = %q", got)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var logs bytes.Buffer
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stop := Start(r.Context(), "db")
+		time.Sleep(2 * time.Millisecond)
+		stop()
+		stop() // Ending a phase twice records it once.
+
+		stop = StartDesc(r.Context(), "tmpl", "render template")
+		stop()
+
+		w.WriteHeader(http.StatusTeapot)
+		// Phases ended after the header is written are only logged.
+		Start(r.Context(), "late")()
+		w.Write([]byte("ok"))
+	}), Options{
+		Logger: log.New(&logs, "", 0),
+		Style:  func(d time.Duration) string { return "T" },
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/items?page=2", nil))
+
+	if w.Code != http.StatusTeapot || w.Body.String() != "ok" {
+		t.Errorf("response %d %q", w.Code, w.Body.String())
+	}
+	header := regexp.MustCompile(`^db;dur=([0-9.]+), tmpl;dur=[0-9.]+;desc="render template", total;dur=([0-9.]+)$`)
+	if !header.MatchString(w.Header().Get("Server-Timing")) {
+		t.Errorf("Server-Timing = %q", w.Header().Get("Server-Timing"))
+	}
+	if got := logs.String(); got != "GET /items?page=2 418 in T (db T, tmpl T, late T)\n" {
+		t.Errorf("log = %q", got)
+	}
+}
+
+func TestMiddleware_ImplicitWrite(t *testing.T) {
+	var logs bytes.Buffer
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Start(r.Context(), "work")()
+	}), Options{Logger: log.New(&logs, "", 0), Total: "app"})
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/ping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d", resp.StatusCode)
+	}
+	if !regexp.MustCompile(`^work;dur=[0-9.]+, app;dur=[0-9.]+$`).MatchString(resp.Header.Get("Server-Timing")) {
+		t.Errorf("Server-Timing = %q", resp.Header.Get("Server-Timing"))
+	}
+	// The default style outputs the first two elements.
+	if !regexp.MustCompile(`^GET /ping 200 in \d+ \w+( \d+ \w+)? \(work \d+ \w+( \d+ \w+)?\)\n$`).MatchString(logs.String()) {
+		t.Errorf("log = %q", logs.String())
+	}
+}
+
+func TestMiddleware_SuperfluousWriteHeader(t *testing.T) {
+	tests := []struct {
+		handler  http.HandlerFunc
+		expected int
+	}{
+		{func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			w.WriteHeader(http.StatusInternalServerError)
+		}, http.StatusCreated},
+		{func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("ok"))
+			w.WriteHeader(http.StatusInternalServerError)
+		}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		var logs bytes.Buffer
+		handler := Middleware(tt.handler, Options{Logger: log.New(&logs, "", 0)})
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		if w.Code != tt.expected {
+			t.Errorf("status = %d, expected %d", w.Code, tt.expected)
+		}
+		if !strings.HasPrefix(logs.String(), "GET / "+strconv.Itoa(tt.expected)+" in ") {
+			t.Errorf("log = %q", logs.String())
+		}
+	}
+}
+
+func TestMiddleware_Informational(t *testing.T) {
+	var logs bytes.Buffer
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", "</style.css>; rel=preload")
+		w.WriteHeader(http.StatusEarlyHints)
+		w.WriteHeader(http.StatusNotFound)
+	}), Options{Logger: log.New(&logs, "", 0)})
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, expected 404", resp.StatusCode)
+	}
+	if resp.Header.Get("Server-Timing") == "" {
+		t.Errorf("no Server-Timing header")
+	}
+	if !strings.HasPrefix(logs.String(), "GET / 404 in ") {
+		t.Errorf("log = %q", logs.String())
+	}
+}
+
+func TestMiddleware_Hijack(t *testing.T) {
+	var logs bytes.Buffer
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, rw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		rw.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+		rw.Flush()
+	}), Options{Logger: log.New(&logs, "", 0)})
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + "/ws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "hijacked" {
+		t.Errorf("body = %q", body)
+	}
+	if !strings.HasPrefix(logs.String(), "GET /ws 101 in ") {
+		t.Errorf("log = %q", logs.String())
+	}
+
+	// Without a Hijacker to forward to.
+	Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := w.(http.Hijacker).Hijack(); err == nil {
+			t.Errorf("Hijack() of a ResponseRecorder, got nil error")
+		}
+	}), Options{}).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+}
+
+func TestStart_WithoutMiddleware(t *testing.T) {
+	Start(context.Background(), "orphan")()
+	if m := FromContext(context.Background()).Metrics(); m != nil {
+		t.Errorf("Metrics() = %v, expected nil", m)
+	}
+}
@@ -0,0 +1,50 @@
+package durafmt
+
+// SignStyle the way the sign of a duration is output.
+type SignStyle int
+
+const (
+	// SignMinus a leading "-" for negative durations, "-1 minute". The default.
+	SignMinus SignStyle = iota
+	// SignWord a leading "minus" for negative durations, "minus 1 minute".
+	SignWord
+	// SignAgo an "ago" suffix for negative durations, "1 minute ago".
+	SignAgo
+	// SignParens parentheses around negative durations, as in accounting, "(1 minute)".
+	SignParens
+	// SignPlus a leading "-" for negative and "+" for positive durations, "+1 minute".
+	SignPlus
+)
+
+// Sign sets the output format, outputing the sign of the duration with style.
+func (d *Durafmt) Sign(style SignStyle) *Durafmt {
+	d.sign = style
+	return d
+}
+
+// negative reports whether d is output as a negative duration.
+// Zero durations parsed from a string keep their sign, "-0h" is "-0 hours".
+func (d *Durafmt) negative() bool {
+	if d.duration == 0 {
+		return d.input != "" && d.input[0] == '-'
+	}
+	return d.value() < 0
+}
+
+// apply adds the sign to the formatted duration s.
+func (style SignStyle) apply(s string, negative, positive bool) string {
+	switch {
+	case negative && style == SignWord:
+		return "minus " + s
+	case negative && style == SignAgo:
+		return s + " ago"
+	case negative && style == SignParens:
+		return "(" + s + ")"
+	case negative:
+		return "-" + s
+	case positive && style == SignPlus:
+		return "+" + s
+	default:
+		return s
+	}
+}
@@ -0,0 +1,71 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurafmt_Sign(t *testing.T) {
+	tests := []struct {
+		test     time.Duration
+		style    SignStyle
+		expected string
+		intl     string
+		clock    string
+	}{
+		{-100 * time.Second, SignMinus, "-1 minute 40 seconds", "-1 m 40 s", "-0:01:40"},
+		{-100 * time.Second, SignWord, "minus 1 minute 40 seconds", "minus 1 m 40 s", "minus 0:01:40"},
+		{-100 * time.Second, SignAgo, "1 minute 40 seconds ago", "1 m 40 s ago", "0:01:40 ago"},
+		{-100 * time.Second, SignParens, "(1 minute 40 seconds)", "(1 m 40 s)", "(0:01:40)"},
+		{-100 * time.Second, SignPlus, "-1 minute 40 seconds", "-1 m 40 s", "-0:01:40"},
+		{100 * time.Second, SignPlus, "+1 minute 40 seconds", "+1 m 40 s", "+0:01:40"},
+		{100 * time.Second, SignAgo, "1 minute 40 seconds", "1 m 40 s", "0:01:40"},
+		{0, SignPlus, "0 seconds", "0 s", "0:00:00"},
+	}
+
+	for _, tt := range tests {
+		d := Parse(tt.test).Sign(tt.style)
+		if got := d.String(); got != tt.expected {
+			t.Errorf("Parse(%v).Sign(%d).String() = %q, expected %q", tt.test, tt.style, got, tt.expected)
+		}
+		if got := d.InternationalString(); got != tt.intl {
+			t.Errorf("Parse(%v).Sign(%d).InternationalString() = %q, expected %q", tt.test, tt.style, got, tt.intl)
+		}
+		if got := d.ClockString(); got != tt.clock {
+			t.Errorf("Parse(%v).Sign(%d).ClockString() = %q, expected %q", tt.test, tt.style, got, tt.clock)
+		}
+		if tt.test != 0 {
+			h, err := ParseHuman(tt.expected)
+			if err != nil || h.Duration() != tt.test {
+				t.Errorf("ParseHuman(%q) = %v, %v, expected %v", tt.expected, h, err, tt.test)
+			}
+		}
+	}
+}
+
+// TestDurafmt_SignFromDuration for durations whose input does not carry their sign.
+func TestDurafmt_SignFromDuration(t *testing.T) {
+	tests := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{&Durafmt{}, "0 seconds"},
+		{&Durafmt{duration: -time.Minute}, "-1 minute"},
+		{&Durafmt{duration: time.Minute, input: "-1m"}, "1 minute"},
+		{Parse(-time.Minute).LimitFirstN(1).Sign(SignParens), "(1 minute)"},
+		{Parse(-1), "-0 microseconds"},
+	}
+	for _, tt := range tests {
+		if got := tt.test.String(); got != tt.expected {
+			t.Errorf("String() = %q, expected %q", got, tt.expected)
+		}
+	}
+
+	d, err := ParseString("-0h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Sign(SignWord).String(); got != "minus 0 hours" {
+		t.Errorf("ParseString(%q).String() = %q", "-0h", got)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build go1.21
+// +build go1.21
+
+package durafmt
+
+import (
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer, logging d as a group with the human
+// readable duration as "text" and the duration in nanoseconds as "ns".
+func (d *Durafmt) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("text", d.String()),
+		slog.Int64("ns", int64(d.duration)),
+	)
+}
+
+// ReplaceAttr returns a function for slog.HandlerOptions.ReplaceAttr that
+// rewrites every slog.KindDuration attribute through style, LongStyle if nil.
+func ReplaceAttr(style Style) func(groups []string, a slog.Attr) slog.Attr {
+	if style == nil {
+		style = LongStyle
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if a.Value.Kind() == slog.KindDuration {
+			a.Value = slog.StringValue(style(a.Value.Duration()))
+		}
+		return a
+	}
+}
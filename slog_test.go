@@ -0,0 +1,62 @@
+//go:build go1.21
+// +build go1.21
+
+package durafmt
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurafmt_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("done", "elapsed", Parse(354*time.Hour+22*time.Minute+3*time.Second))
+
+	expected := `level=INFO msg=done elapsed.text="2 weeks 18 hours 22 minutes 3 seconds" elapsed.ns=1275723000000000` + "\n"
+	if buf.String() != expected {
+		t.Errorf("got %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestReplaceAttr(t *testing.T) {
+	tests := []struct {
+		style    Style
+		expected string
+	}{
+		{nil, `elapsed="1 minute 40 seconds" attempts=3 req.latency="2 seconds 500 milliseconds"`},
+		{ShortStyle, `elapsed="1 minute" attempts=3 req.latency="2 seconds"`},
+		{InternationalStyle, `elapsed="1 m 40 s" attempts=3 req.latency="2 s 500 ms"`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		replace := ReplaceAttr(tt.style)
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+					return slog.Attr{}
+				}
+				return replace(groups, a)
+			},
+		}))
+		logger.Info("done",
+			"elapsed", 100*time.Second,
+			"attempts", 3,
+			slog.Group("req", "latency", 2500*time.Millisecond))
+
+		if got := strings.TrimSpace(buf.String()); got != tt.expected {
+			t.Errorf("got %q, expected %q", got, tt.expected)
+		}
+	}
+}
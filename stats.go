@@ -0,0 +1,194 @@
+package durafmt
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultStatsSamples default number of durations a Stats keeps for percentiles.
+const DefaultStatsSamples = 1024
+
+// statsPercentiles the percentiles output by Stats.String.
+var statsPercentiles = []float64{50, 90, 99}
+
+// Stats accumulates durations, keeping their count, min, max, mean and
+// standard deviation, and approximate percentiles from a uniform sample.
+// The zero Stats is ready to use. It is safe for concurrent use.
+type Stats struct {
+	mu       sync.Mutex
+	n        int64
+	min, max time.Duration
+	mean, m2 float64 // Running mean and sum of squared differences, in nanoseconds.
+	size     int
+	samples  []time.Duration
+	sorted   bool
+	rng      *rand.Rand
+}
+
+// NewStats creates a new *Stats keeping up to samples durations for percentiles,
+// DefaultStatsSamples if samples <= 0. Percentiles are exact until more durations are added.
+func NewStats(samples int) *Stats {
+	return &Stats{size: samples}
+}
+
+// Add adds durations to s.
+func (s *Stats) Add(durations ...time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size <= 0 {
+		s.size = DefaultStatsSamples
+	}
+	for _, d := range durations {
+		s.n++
+		if s.n == 1 || d < s.min {
+			s.min = d
+		}
+		if s.n == 1 || d > s.max {
+			s.max = d
+		}
+		// Welford's online algorithm.
+		delta := float64(d) - s.mean
+		s.mean += delta / float64(s.n)
+		s.m2 += delta * (float64(d) - s.mean)
+
+		// Reservoir sampling, every duration added has the same chance to be kept.
+		if len(s.samples) < s.size {
+			s.samples = append(s.samples, d)
+		} else {
+			if s.rng == nil {
+				s.rng = rand.New(rand.NewSource(1))
+			}
+			if i := s.rng.Int63n(s.n); i < int64(s.size) {
+				s.samples[i] = d
+			}
+		}
+		s.sorted = false
+	}
+}
+
+// Count returns the number of durations added.
+func (s *Stats) Count() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.n
+}
+
+// Min returns the smallest duration added.
+func (s *Stats) Min() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.min
+}
+
+// Max returns the largest duration added.
+func (s *Stats) Max() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.max
+}
+
+// Mean returns the mean of the durations added.
+func (s *Stats) Mean() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Duration(s.mean)
+}
+
+// Stddev returns the population standard deviation of the durations added.
+func (s *Stats) Stddev() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stddev()
+}
+
+func (s *Stats) stddev() time.Duration {
+	if s.n == 0 {
+		return 0
+	}
+	return time.Duration(math.Sqrt(s.m2 / float64(s.n)))
+}
+
+// Percentile returns the p-th percentile, 0 <= p <= 100, of the durations added,
+// interpolated between the closest ranks of the kept sample.
+func (s *Stats) Percentile(p float64) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.percentile(p)
+}
+
+func (s *Stats) percentile(p float64) time.Duration {
+	if len(s.samples) == 0 {
+		return 0
+	}
+	if !s.sorted {
+		sort.Slice(s.samples, func(i, j int) bool { return s.samples[i] < s.samples[j] })
+		s.sorted = true
+	}
+	if p <= 0 {
+		return s.samples[0]
+	}
+	if p >= 100 {
+		return s.samples[len(s.samples)-1]
+	}
+	rank := p / 100 * float64(len(s.samples)-1)
+	i := int(rank)
+	if i+1 >= len(s.samples) {
+		return s.samples[i]
+	}
+	lo, hi := float64(s.samples[i]), float64(s.samples[i+1])
+	return time.Duration(lo + (hi-lo)*(rank-float64(i)))
+}
+
+// String formats s with the default units, see Format.
+func (s *Stats) String() string {
+	return s.Format(units)
+}
+
+// Format formats the count, min, max, mean, standard deviation and 50th, 90th
+// and 99th percentiles of s with units, e.g.
+// "count 3, min 1.0 seconds, max 3.0 seconds, mean 2.0 seconds, ...".
+// All the figures are output with one decimal in the same unit, the largest
+// unit not longer than the median, so they are easy to compare.
+func (s *Stats) Format(units Units) string {
+	return s.format(func(d *Durafmt) string {
+		return d.Format(units)
+	})
+}
+
+// InternationalString formats s like Format, with international units.
+func (s *Stats) InternationalString() string {
+	return s.format((*Durafmt).InternationalString)
+}
+
+func (s *Stats) format(out func(d *Durafmt) string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type figure struct {
+		label string
+		value time.Duration
+	}
+	figures := []figure{
+		{"min", s.min},
+		{"max", s.max},
+		{"mean", time.Duration(s.mean)},
+		{"stddev", s.stddev()},
+	}
+	for _, p := range statsPercentiles {
+		figures = append(figures, figure{"p" + strconv.FormatFloat(p, 'f', -1, 64), s.percentile(p)})
+	}
+
+	one := 1
+	c := config{decimals: &one}
+	c.limitUnit = limitUnits[c.commonUnit(DefaultUnitSystem, s.percentile(50))]
+	parts := []string{"count " + strconv.FormatInt(s.n, 10)}
+	for _, f := range figures {
+		parts = append(parts, f.label+" "+out(&Durafmt{duration: f.value, config: c}))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,93 @@
+package durafmt
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	var s Stats
+	if got := s.String(); got != "count 0, min 0.0 microseconds, max 0.0 microseconds, mean 0.0 microseconds, stddev 0.0 microseconds, p50 0.0 microseconds, p90 0.0 microseconds, p99 0.0 microseconds" {
+		t.Errorf("String() of empty Stats = %q", got)
+	}
+
+	for i := 1; i <= 100; i++ {
+		s.Add(time.Duration(i) * 100 * time.Millisecond)
+	}
+	if s.Count() != 100 || s.Min() != 100*time.Millisecond || s.Max() != 10*time.Second {
+		t.Errorf("Count() = %d, Min() = %v, Max() = %v", s.Count(), s.Min(), s.Max())
+	}
+	if s.Mean() != 5050*time.Millisecond {
+		t.Errorf("Mean() = %v", s.Mean())
+	}
+	if d := s.Stddev() - 2886607004; d < -time.Microsecond || d > time.Microsecond {
+		t.Errorf("Stddev() = %v", s.Stddev())
+	}
+	if s.Percentile(50) != 5050*time.Millisecond || s.Percentile(0) != 100*time.Millisecond || s.Percentile(100) != 10*time.Second {
+		t.Errorf("Percentile() = %v, %v, %v", s.Percentile(50), s.Percentile(0), s.Percentile(100))
+	}
+	if s.Percentile(99) != 9901*time.Millisecond {
+		t.Errorf("Percentile(99) = %v", s.Percentile(99))
+	}
+
+	expected := "count 100, min 0.1 seconds, max 10.0 seconds, mean 5.0 seconds, stddev 2.9 seconds, p50 5.0 seconds, p90 9.0 seconds, p99 9.9 seconds"
+	if got := s.String(); got != expected {
+		t.Errorf("String() = %q, expected %q", got, expected)
+	}
+	if got := s.InternationalString(); got != "count 100, min 0.1 s, max 10.0 s, mean 5.0 s, stddev 2.9 s, p50 5.0 s, p90 9.0 s, p99 9.9 s" {
+		t.Errorf("InternationalString() = %q", got)
+	}
+}
+
+func TestStats_CommonUnit(t *testing.T) {
+	s := NewStats(0)
+	s.Add(1200*time.Millisecond, 1500*time.Millisecond, 3*time.Minute)
+	expected := "count 3, min 1.2 seconds, max 180.0 seconds, mean 60.9 seconds, stddev 84.2 seconds, p50 1.5 seconds, p90 144.3 seconds, p99 176.4 seconds"
+	if got := s.String(); got != expected {
+		t.Errorf("String() = %q, expected %q", got, expected)
+	}
+
+	units, err := DefaultUnitsCoder.Decode("ano,semana,dia,hora,minuto,segundo,milissegundo,microssegundo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s = NewStats(0)
+	s.Add(90*time.Minute, 2*time.Hour, 150*time.Minute)
+	if got := s.Format(units); got != "count 3, min 1.5 horas, max 2.5 horas, mean 2.0 horas, stddev 0.4 horas, p50 2.0 horas, p90 2.4 horas, p99 2.5 horas" {
+		t.Errorf("Format() = %q", got)
+	}
+}
+
+func TestStats_Reservoir(t *testing.T) {
+	s := NewStats(100)
+	for i := 0; i < 10000; i++ {
+		s.Add(time.Duration(i) * time.Millisecond)
+	}
+	if s.Count() != 10000 || s.Min() != 0 || s.Max() != 9999*time.Millisecond {
+		t.Errorf("Count() = %d, Min() = %v, Max() = %v", s.Count(), s.Min(), s.Max())
+	}
+	// The median of a uniform sample of 100 is close to the true median.
+	if p50 := s.Percentile(50); p50 < 4*time.Second || p50 > 6*time.Second {
+		t.Errorf("Percentile(50) = %v, expected about 5s", p50)
+	}
+}
+
+func TestStats_Concurrent(t *testing.T) {
+	var s Stats
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				s.Add(time.Second)
+				_ = s.Percentile(90)
+			}
+		}()
+	}
+	wg.Wait()
+	if s.Count() != 8000 || s.Mean() != time.Second || s.Stddev() != 0 {
+		t.Errorf("Count() = %d, Mean() = %v, Stddev() = %v", s.Count(), s.Mean(), s.Stddev())
+	}
+}
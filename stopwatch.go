@@ -0,0 +1,152 @@
+package durafmt
+
+import (
+	"sync"
+	"time"
+)
+
+// Lap a lap recorded by a Stopwatch.
+type Lap struct {
+	// N number of the lap, starting at 1.
+	N int
+	// Duration time since the previous lap, or the start of the stopwatch.
+	Duration time.Duration
+	// Total time since the start of the stopwatch.
+	Total time.Duration
+
+	style Style
+}
+
+// String formats the lap with the style of its stopwatch,
+// e.g. "2 minutes 4 seconds (total 10 minutes)".
+func (l Lap) String() string {
+	style := l.style
+	if style == nil {
+		style = LongStyle
+	}
+	return style(l.Duration) + " (total " + style(l.Total) + ")"
+}
+
+// Stopwatch measures laps and the running total of a process,
+// formatting them with a Style.
+// The zero Stopwatch is stopped and formats with LongStyle, ready to use.
+// It is safe for concurrent use.
+type Stopwatch struct {
+	mu      sync.Mutex
+	style   Style            // LongStyle if nil.
+	now     func() time.Time // time.Now if nil.
+	start   time.Time
+	last    time.Time
+	stop    time.Time
+	running bool
+	laps    []Lap
+}
+
+// NewStopwatch creates a new stopped *Stopwatch formatting with style, LongStyle if nil.
+func NewStopwatch(style Style) *Stopwatch {
+	if style == nil {
+		style = LongStyle
+	}
+	return &Stopwatch{style: style, now: time.Now}
+}
+
+// StartStopwatch creates and starts a new *Stopwatch formatting with style, LongStyle if nil.
+func StartStopwatch(style Style) *Stopwatch {
+	s := NewStopwatch(style)
+	s.Start()
+	return s
+}
+
+// Start starts s, discarding any previous laps.
+func (s *Stopwatch) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.start = s.clock()
+	s.last = s.start
+	s.running = true
+	s.laps = nil
+}
+
+// clock returns the current time.
+func (s *Stopwatch) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// current returns the time s is measured at, the stop time if it is stopped.
+func (s *Stopwatch) current() time.Time {
+	if s.running {
+		return s.clock()
+	}
+	return s.stop
+}
+
+// split returns the current lap without recording it.
+func (s *Stopwatch) split() Lap {
+	if s.start.IsZero() {
+		return Lap{N: 1, style: s.style}
+	}
+	now := s.current()
+	return Lap{
+		N:        len(s.laps) + 1,
+		Duration: now.Sub(s.last),
+		Total:    now.Sub(s.start),
+		style:    s.style,
+	}
+}
+
+// Lap records and returns a lap, the time since the previous lap.
+// Laps of a stopped stopwatch end at its stop time.
+func (s *Stopwatch) Lap() Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.split()
+	if !s.start.IsZero() {
+		s.last = s.last.Add(l.Duration)
+		s.laps = append(s.laps, l)
+	}
+	return l
+}
+
+// Split returns the current lap and running total without recording a lap.
+func (s *Stopwatch) Split() Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.split()
+}
+
+// Stop stops s and returns the current lap and total, which stay fixed until s is started again.
+func (s *Stopwatch) Stop() Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		s.stop = s.clock()
+		s.running = false
+	}
+	return s.split()
+}
+
+// Laps returns the recorded laps.
+func (s *Stopwatch) Laps() []Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	laps := make([]Lap, len(s.laps))
+	copy(laps, s.laps)
+	return laps
+}
+
+// Elapsed returns the running total of s.
+func (s *Stopwatch) Elapsed() time.Duration {
+	return s.Split().Total
+}
+
+// String formats the running total of s with its style.
+func (s *Stopwatch) String() string {
+	style := s.style
+	if style == nil {
+		style = LongStyle
+	}
+	return style(s.Elapsed())
+}
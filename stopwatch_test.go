@@ -0,0 +1,158 @@
+package durafmt
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock a clock advanced by tests.
+type fakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{t: time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.t = c.t.Add(d)
+	c.mu.Unlock()
+}
+
+func TestStopwatch(t *testing.T) {
+	clock := newFakeClock()
+	s := NewStopwatch(nil)
+	s.now = clock.Now
+
+	if l := s.Lap(); l.Duration != 0 || l.Total != 0 || len(s.Laps()) != 0 {
+		t.Errorf("Lap() before Start() = %+v, expected zero lap", l)
+	}
+
+	s.Start()
+	clock.Advance(2*time.Minute + 4*time.Second)
+	if got := s.Lap().String(); got != "2 minutes 4 seconds (total 2 minutes 4 seconds)" {
+		t.Errorf("Lap() = %q", got)
+	}
+	clock.Advance(7*time.Minute + 56*time.Second)
+	if got := s.Split().String(); got != "7 minutes 56 seconds (total 10 minutes)" {
+		t.Errorf("Split() = %q", got)
+	}
+	clock.Advance(time.Minute)
+	l := s.Lap()
+	if l.N != 2 || l.Duration != 8*time.Minute+56*time.Second || l.Total != 11*time.Minute {
+		t.Errorf("Lap() = %+v", l)
+	}
+	if got := s.String(); got != "11 minutes" {
+		t.Errorf("String() = %q", got)
+	}
+
+	clock.Advance(30 * time.Second)
+	stop := s.Stop()
+	clock.Advance(time.Hour)
+	if stop.N != 3 || stop.Duration != 30*time.Second || stop.Total != 11*time.Minute+30*time.Second {
+		t.Errorf("Stop() = %+v", stop)
+	}
+	if s.Elapsed() != stop.Total {
+		t.Errorf("Elapsed() after Stop() = %v, expected %v", s.Elapsed(), stop.Total)
+	}
+	if laps := s.Laps(); len(laps) != 2 || laps[0].N != 1 || laps[1].N != 2 {
+		t.Errorf("Laps() = %+v", laps)
+	}
+
+	s.Start()
+	if len(s.Laps()) != 0 || s.Elapsed() != 0 {
+		t.Errorf("Start() did not reset the stopwatch")
+	}
+}
+
+func TestStopwatch_Styles(t *testing.T) {
+	clock := newFakeClock()
+	tests := []struct {
+		style    Style
+		expected string
+	}{
+		{ShortStyle, "2 minutes (total 1 hour)"},
+		{ClockStyle, "0:02:04 (total 1:02:04)"},
+		{InternationalStyle, "2 m 4 s (total 1 h 2 m 4 s)"},
+	}
+	for _, tt := range tests {
+		s := NewStopwatch(tt.style)
+		s.now = clock.Now
+		s.Start()
+		clock.Advance(time.Hour)
+		s.Lap()
+		clock.Advance(2*time.Minute + 4*time.Second)
+		if got := s.Lap().String(); got != tt.expected {
+			t.Errorf("Lap() = %q, expected %q", got, tt.expected)
+		}
+	}
+}
+
+func TestStopwatch_Zero(t *testing.T) {
+	var s Stopwatch
+	if got := s.String(); got != "0 seconds" {
+		t.Errorf("String() of an unstarted zero Stopwatch = %q", got)
+	}
+	s.Start()
+	time.Sleep(time.Millisecond)
+	s.Lap()
+	if l := s.Stop(); l.N != 2 || l.Total < time.Millisecond {
+		t.Errorf("Stop() = %+v", l)
+	}
+	if got := s.String(); got == "" || got == "0 seconds" {
+		t.Errorf("String() of a stopped zero Stopwatch = %q", got)
+	}
+
+	clock := newFakeClock()
+	s = Stopwatch{now: clock.Now}
+	s.Start()
+	clock.Advance(90 * time.Second)
+	if got := s.Lap().String(); got != "1 minute 30 seconds (total 1 minute 30 seconds)" {
+		t.Errorf("Lap() = %q", got)
+	}
+}
+
+func TestStopwatch_Concurrent(t *testing.T) {
+	clock := newFakeClock()
+	s := NewStopwatch(nil)
+	s.now = clock.Now
+	s.Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				clock.Advance(time.Millisecond)
+				s.Lap()
+				_ = s.Split().String()
+			}
+		}()
+	}
+	wg.Wait()
+
+	laps := s.Laps()
+	if len(laps) != 800 {
+		t.Fatalf("len(Laps()) = %d, expected 800", len(laps))
+	}
+	var sum time.Duration
+	for i, l := range laps {
+		if l.N != i+1 {
+			t.Errorf("Laps()[%d].N = %d", i, l.N)
+		}
+		sum += l.Duration
+	}
+	if sum != laps[len(laps)-1].Total {
+		t.Errorf("sum of laps %v, expected total %v", sum, laps[len(laps)-1].Total)
+	}
+}
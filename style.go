@@ -0,0 +1,26 @@
+package durafmt
+
+import "time"
+
+// Style renders a time.Duration as a string.
+type Style func(d time.Duration) string
+
+var (
+	// LongStyle renders durations like `Parse(d).String()`, e.g. "2 weeks 18 hours 22 minutes 3 seconds".
+	LongStyle Style = func(d time.Duration) string { return Parse(d).String() }
+	// ShortStyle renders durations like `ParseShort(d).String()`, e.g. "2 weeks".
+	ShortStyle Style = func(d time.Duration) string { return ParseShort(d).String() }
+	// InternationalStyle renders durations like `Parse(d).InternationalString()`, e.g. "2 w 18 h 22 m 3 s".
+	InternationalStyle Style = func(d time.Duration) string { return Parse(d).InternationalString() }
+)
+
+// UnitsStyle returns a Style rendering durations with units, outputting only
+// the first n elements, n == 0 means no limit.
+func UnitsStyle(units Units, n int) Style {
+	return func(d time.Duration) string {
+		return Parse(d).LimitFirstN(n).Format(units)
+	}
+}
+
+// ClockStyle renders durations like `Parse(d).ClockString()`, e.g. "354:22:03".
+var ClockStyle Style = func(d time.Duration) string { return Parse(d).ClockString() }
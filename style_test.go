@@ -0,0 +1,32 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStyles(t *testing.T) {
+	units, err := DefaultUnitsCoder.Decode("ano,semana,dia,hora,minuto,segundo,milissegundo,microssegundo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := 354*time.Hour + 22*time.Minute + 3*time.Second
+
+	tests := []struct {
+		style    Style
+		expected string
+	}{
+		{LongStyle, "2 weeks 18 hours 22 minutes 3 seconds"},
+		{ShortStyle, "2 weeks"},
+		{InternationalStyle, "2 w 18 h 22 m 3 s"},
+		{ClockStyle, "354:22:03"},
+		{UnitsStyle(units, 0), "2 semanas 18 horas 22 minutos 3 segundos"},
+		{UnitsStyle(units, 2), "2 semanas 18 horas"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.style(d); got != tt.expected {
+			t.Errorf("style(%v) = %q, expected %q", d, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package durafmt
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SystemUnit a unit of a UnitSystem.
+type SystemUnit struct {
+	Unit          // Singular and plural names, "hour" and "hours".
+	Short  string // Abbreviation output by InternationalString, "h".
+	Length time.Duration
+}
+
+// UnitSystem units of duration, from the longest to the shortest, e.g. years
+// to microseconds, or shifts of 8 hours, hours and minutes.
+type UnitSystem []SystemUnit
+
+// DefaultUnitSystem the default units, years to microseconds.
+var DefaultUnitSystem = units.System()
+
+// System returns u as a UnitSystem, with the lengths of the default units and
+// international abbreviations.
+func (u Units) System() UnitSystem {
+	s := make(UnitSystem, len(unitLengths))
+	for i, unit := range u.Units() {
+		s[i] = SystemUnit{unit, unitsShort[i], unitLengths[i]}
+	}
+	return s
+}
+
+// NewUnitSystem creates a UnitSystem of units, sorted from the longest to the shortest.
+// A unit without a Plural or Short name uses its Singular name.
+// returns an error if a unit has no Singular name or no positive Length, or
+// if two units have the same Length.
+func NewUnitSystem(units ...SystemUnit) (UnitSystem, error) {
+	if len(units) == 0 {
+		return nil, errors.New("durafmt: unit system without units")
+	}
+	s := make(UnitSystem, len(units))
+	copy(s, units)
+	for i, u := range s {
+		if u.Singular == "" {
+			return nil, errors.New("durafmt: unit " + strconv.Itoa(i) + " has no name")
+		}
+		if u.Length <= 0 {
+			return nil, errors.New("durafmt: unit " + strconv.Quote(u.Singular) + " has no length")
+		}
+		if u.Plural == "" {
+			s[i].Plural = u.Singular
+		}
+		if u.Short == "" {
+			s[i].Short = u.Singular
+		}
+	}
+	sort.SliceStable(s, func(i, j int) bool { return s[i].Length > s[j].Length })
+	for i := 1; i < len(s); i++ {
+		if s[i].Length == s[i-1].Length {
+			return nil, errors.New("durafmt: units " + strconv.Quote(s[i-1].Singular) + " and " + strconv.Quote(s[i].Singular) + " have the same length")
+		}
+	}
+	return s, nil
+}
+
+// index returns the index of the unit named name, by its singular, plural or
+// short name, or else the largest unit not longer than the default unit named
+// name, "hours". Unknown names are the smallest unit.
+func (s UnitSystem) index(name string) int {
+	for i, u := range s {
+		if name == u.Plural || name == u.Singular || name == u.Short {
+			return i
+		}
+	}
+	for j, n := range limitUnits {
+		if n != name {
+			continue
+		}
+		for i, u := range s {
+			if u.Length <= unitLengths[j] {
+				return i
+			}
+		}
+	}
+	return len(s) - 1
+}
@@ -0,0 +1,110 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testSprints, _ = NewUnitSystem(
+	SystemUnit{Unit{"shift", "shifts"}, "sh", 8 * time.Hour},
+	SystemUnit{Unit{"sprint", "sprints"}, "sp", 2 * 7 * 24 * time.Hour},
+	SystemUnit{Unit{"hour", "hours"}, "h", time.Hour},
+	SystemUnit{Unit{"minute", "minutes"}, "m", time.Minute},
+)
+
+func TestNewUnitSystem(t *testing.T) {
+	var names []string
+	for _, u := range testSprints {
+		names = append(names, u.Plural)
+	}
+	if expected := []string{"sprints", "shifts", "hours", "minutes"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("NewUnitSystem() units = %q, expected %q", names, expected)
+	}
+
+	s, err := NewUnitSystem(SystemUnit{Unit: Unit{Singular: "tick"}, Length: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("NewUnitSystem() error: %v", err)
+	}
+	if expected := (SystemUnit{Unit{"tick", "tick"}, "tick", 50 * time.Millisecond}); s[0] != expected {
+		t.Errorf("NewUnitSystem() unit = %v, expected %v", s[0], expected)
+	}
+
+	invalid := [][]SystemUnit{
+		nil,
+		{{Unit{"", "ticks"}, "t", time.Second}},
+		{{Unit{"tick", "ticks"}, "t", 0}},
+		{{Unit{"tick", "ticks"}, "t", -time.Second}},
+		{{Unit{"tick", "ticks"}, "t", time.Second}, {Unit{"second", "seconds"}, "s", time.Second}},
+	}
+	for _, units := range invalid {
+		if _, err := NewUnitSystem(units...); err == nil {
+			t.Errorf("NewUnitSystem(%v) expected an error", units)
+		}
+	}
+}
+
+func TestDurafmt_FormatSystem(t *testing.T) {
+	d := 3*7*24*time.Hour + 17*time.Hour + 30*time.Minute + 20*time.Second
+	tests := []struct {
+		d         time.Duration
+		limitUnit string
+		limitN    int
+		expected  string
+	}{
+		{d, "", 0, "1 sprint 23 shifts 1 hour 30 minutes"},
+		{d, "", 2, "1 sprint 23 shifts"},
+		{d, "shifts", 0, "65 shifts 1 hour 30 minutes"},
+		{d, "shift", 0, "65 shifts 1 hour 30 minutes"},
+		{d, "sh", 0, "65 shifts 1 hour 30 minutes"},
+		{d, "days", 0, "65 shifts 1 hour 30 minutes"},
+		{d, "hours", 0, "521 hours 30 minutes"},
+		{d, "seconds", 0, "31290 minutes"},
+		{d, "unknown", 0, "31290 minutes"},
+		{0, "", 0, "0 minutes"},
+		{30 * time.Second, "", 0, "0 minutes"},
+		{-9 * time.Hour, "", 0, "-1 shift 1 hour"},
+	}
+	for _, tt := range tests {
+		got := Parse(tt.d).LimitToUnit(tt.limitUnit).LimitFirstN(tt.limitN).FormatSystem(testSprints)
+		if got != tt.expected {
+			t.Errorf("Parse(%v).LimitToUnit(%q).LimitFirstN(%d).FormatSystem() = %q, expected %q", tt.d, tt.limitUnit, tt.limitN, got, tt.expected)
+		}
+	}
+
+	// The default units are a UnitSystem.
+	for _, d := range []time.Duration{0, time.Microsecond, 354*time.Hour + 3*time.Second, -time.Minute} {
+		if got, expected := Parse(d).FormatSystem(DefaultUnitSystem), Parse(d).String(); got != expected {
+			t.Errorf("Parse(%v).FormatSystem(DefaultUnitSystem) = %q, expected %q", d, got, expected)
+		}
+	}
+	if got := Parse(time.Hour).FormatSystem(units.System()); got != "1 hour" {
+		t.Errorf("Parse(1h).FormatSystem(units.System()) = %q", got)
+	}
+}
+
+func TestWithUnitSystem(t *testing.T) {
+	d := 17*time.Hour + 30*time.Minute
+	tests := []struct {
+		opts     []Option
+		expected string
+	}{
+		{[]Option{WithUnitSystem(testSprints)}, "2 shifts 1 hour 30 minutes"},
+		{[]Option{WithUnitSystem(testSprints), WithLayout(LayoutInternational)}, "2 sh 1 h 30 m"},
+		{[]Option{WithUnitSystem(testSprints), WithLocale("pt")}, "2 shifts 1 hour 30 minutes"},
+		{[]Option{WithUnitSystem(testSprints), WithDecimals(1)}, "2.2 shifts"},
+		{[]Option{WithUnitSystem(testSprints), WithDecimals(1), WithLimitToUnit("hours")}, "17.5 hours"},
+		{[]Option{WithUnitSystem(testSprints), WithDayLength(time.Hour)}, "2 shifts 1 hour 30 minutes"},
+		{[]Option{WithUnitSystem(nil), WithLimitFirstN(1)}, "17 hours"},
+	}
+	for _, tt := range tests {
+		if got := New(d, tt.opts...).String(); got != tt.expected {
+			t.Errorf("New(%v).String() = %q, expected %q", d, got, tt.expected)
+		}
+	}
+
+	cells := FormatColumn([]time.Duration{d, 8 * time.Hour, 4 * time.Hour}, WithUnitSystem(testSprints))
+	if expected := []string{"2.2 shifts", "1.0 shifts", "0.5 shifts"}; !reflect.DeepEqual(cells, expected) {
+		t.Errorf("FormatColumn() with a unit system = %q, expected %q", cells, expected)
+	}
+}
@@ -0,0 +1,120 @@
+package durafmt
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"math"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// timeNow returns the current time, replaced in tests.
+var timeNow = time.Now
+
+// toDuration converts a template argument to a time.Duration.
+// It accepts time.Duration, *Durafmt, Go duration strings and integer nanoseconds.
+func toDuration(v interface{}) (time.Duration, error) {
+	switch v := v.(type) {
+	case time.Duration:
+		return v, nil
+	case *Durafmt:
+		return v.duration, nil
+	case string:
+		return time.ParseDuration(v)
+	case int:
+		return time.Duration(v), nil
+	case int64:
+		return time.Duration(v), nil
+	default:
+		return 0, fmt.Errorf("durafmt: cannot use %T as a duration", v)
+	}
+}
+
+// relTime returns the time between now and t, e.g. "3 hours ago" or "in 3 hours".
+func relTime(t time.Time) string {
+	d := timeNow().Sub(t)
+	switch {
+	case d >= time.Second:
+		return ParseShort(d).String() + " ago"
+	case d <= -time.Second:
+		// -d overflows for math.MinInt64, the result of Sub for times far in the future.
+		if d == math.MinInt64 {
+			d++
+		}
+		return "in " + ParseShort(-d).String()
+	default:
+		return "now"
+	}
+}
+
+// templateFuncs returns the template functions, each rendering its duration with render.
+func templateFuncs(render func(d time.Duration, text string) interface{}) map[string]interface{} {
+	duration := func(format func(d *Durafmt) string) func(v interface{}) (interface{}, error) {
+		return func(v interface{}) (interface{}, error) {
+			dur, err := toDuration(v)
+			if err != nil {
+				return nil, err
+			}
+			return render(dur, format(Parse(dur))), nil
+		}
+	}
+	return map[string]interface{}{
+		"humanDuration": duration((*Durafmt).String),
+		"shortDuration": duration(func(d *Durafmt) string { return d.LimitFirstN(1).String() }),
+		"intlDuration":  duration((*Durafmt).InternationalString),
+		"clockDuration": duration((*Durafmt).ClockString),
+		"limitDuration": func(n int, v interface{}) (interface{}, error) {
+			return duration(func(d *Durafmt) string { return d.LimitFirstN(n).String() })(v)
+		},
+		"unitDuration": func(unit string, v interface{}) (interface{}, error) {
+			return duration(func(d *Durafmt) string { return d.LimitToUnit(unit).String() })(v)
+		},
+	}
+}
+
+// FuncMap returns the text/template functions formatting durations:
+//
+//	humanDuration d        2 weeks 18 hours 22 minutes 3 seconds
+//	shortDuration d        2 weeks
+//	intlDuration d         2 w 18 h 22 m 3 s
+//	clockDuration d        354:22:03
+//	limitDuration n d      the first n elements, e.g. 2 weeks 18 hours
+//	unitDuration unit d    no unit bigger than unit, e.g. 14 days 18 hours 22 minutes 3 seconds
+//	relTime t              3 hours ago, in 3 hours
+//
+// Durations may be time.Duration, *Durafmt, Go duration strings or integer nanoseconds,
+// so `{{ .Elapsed | limitDuration 2 }}` works as expected.
+func FuncMap() texttemplate.FuncMap {
+	funcs := templateFuncs(func(d time.Duration, text string) interface{} { return text })
+	funcs["relTime"] = relTime
+	return funcs
+}
+
+// HTMLFuncMap returns the html/template functions of FuncMap.
+// Each function wraps its output in a <time> element with a machine readable
+// datetime attribute, an ISO 8601 duration or, for relTime, an RFC 3339 time:
+//
+//	<time datetime="P14DT18H22M3S">2 weeks 18 hours 22 minutes 3 seconds</time>
+//
+// HTML durations have no sign, the datetime of negative durations is their
+// magnitude and only the text is negative.
+func HTMLFuncMap() htmltemplate.FuncMap {
+	funcs := templateFuncs(func(d time.Duration, text string) interface{} {
+		return timeElement(strings.TrimPrefix(Parse(d).ISO8601String(), "-"), text)
+	})
+	funcs["relTime"] = func(t time.Time) htmltemplate.HTML {
+		return timeElement(t.Format(time.RFC3339Nano), relTime(t))
+	}
+	return funcs
+}
+
+func timeElement(datetime, text string) htmltemplate.HTML {
+	var b strings.Builder
+	b.WriteString(`<time datetime="`)
+	b.WriteString(htmltemplate.HTMLEscapeString(datetime))
+	b.WriteString(`">`)
+	b.WriteString(htmltemplate.HTMLEscapeString(text))
+	b.WriteString(`</time>`)
+	return htmltemplate.HTML(b.String())
+}
@@ -0,0 +1,130 @@
+package durafmt
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"testing"
+	texttemplate "text/template"
+	"time"
+)
+
+func TestFuncMap(t *testing.T) {
+	now := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	data := map[string]interface{}{
+		"Elapsed":   354*time.Hour + 22*time.Minute + 3*time.Second,
+		"Negative":  -100 * time.Second,
+		"Raw":       "1h30m",
+		"CreatedAt": now.Add(-3*time.Hour - 5*time.Minute),
+		"StartsAt":  now.Add(2 * time.Minute),
+		"FarFuture": now.AddDate(400, 0, 0),
+	}
+	tests := []struct {
+		tmpl     string
+		expected string
+	}{
+		{`{{ humanDuration .Elapsed }}`, "2 weeks 18 hours 22 minutes 3 seconds"},
+		{`{{ shortDuration .Elapsed }}`, "2 weeks"},
+		{`{{ intlDuration .Elapsed }}`, "2 w 18 h 22 m 3 s"},
+		{`{{ clockDuration .Elapsed }}`, "354:22:03"},
+		{`{{ .Elapsed | limitDuration 2 }}`, "2 weeks 18 hours"},
+		{`{{ unitDuration "days" .Elapsed }}`, "14 days 18 hours 22 minutes 3 seconds"},
+		{`{{ humanDuration .Negative }}`, "-1 minute 40 seconds"},
+		{`{{ clockDuration .Negative }}`, "-0:01:40"},
+		{`{{ humanDuration .Raw }}`, "1 hour 30 minutes"},
+		{`{{ relTime .CreatedAt }}`, "3 hours ago"},
+		{`{{ relTime .StartsAt }}`, "in 2 minutes"},
+		{`{{ relTime .FarFuture }}`, "in 292 years"},
+	}
+
+	for _, tt := range tests {
+		tmpl := texttemplate.Must(texttemplate.New("").Funcs(FuncMap()).Parse(tt.tmpl))
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Errorf("Execute(%q): %v", tt.tmpl, err)
+			continue
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("Execute(%q) = %q, expected %q", tt.tmpl, buf.String(), tt.expected)
+		}
+	}
+
+	tmpl := texttemplate.Must(texttemplate.New("").Funcs(FuncMap()).Parse(`{{ humanDuration 1.5 }}`))
+	if err := tmpl.Execute(new(bytes.Buffer), nil); err == nil {
+		t.Errorf("Execute with float duration. got nil error, expected an error")
+	}
+}
+
+func TestHTMLFuncMap(t *testing.T) {
+	now := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	data := map[string]interface{}{
+		"Elapsed":   354*time.Hour + 22*time.Minute + 3240*time.Millisecond,
+		"CreatedAt": now.Add(-3 * time.Hour),
+		"Late":      -100 * time.Second,
+	}
+	tests := []struct {
+		tmpl     string
+		expected string
+	}{
+		{`{{ humanDuration .Elapsed }}`, `<time datetime="P14DT18H22M3.24S">2 weeks 18 hours 22 minutes 3 seconds 240 milliseconds</time>`},
+		{`{{ .Elapsed | limitDuration 1 }}`, `<time datetime="P14DT18H22M3.24S">2 weeks</time>`},
+		{`<p title="{{ shortDuration .Elapsed }}">`, `<p title="2 weeks">`},
+		{`{{ humanDuration .Late }}`, `<time datetime="PT1M40S">-1 minute 40 seconds</time>`},
+		{`{{ relTime .CreatedAt }}`, `<time datetime="2020-01-02T12:04:05Z">3 hours ago</time>`},
+	}
+
+	for _, tt := range tests {
+		tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(HTMLFuncMap()).Parse(tt.tmpl))
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Errorf("Execute(%q): %v", tt.tmpl, err)
+			continue
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("Execute(%q) = %q, expected %q", tt.tmpl, buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestDurafmt_ISO8601String(t *testing.T) {
+	tests := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{0, "PT0S"},
+		{time.Nanosecond, "PT0.000000001S"},
+		{1500 * time.Millisecond, "PT1.5S"},
+		{90 * time.Minute, "PT1H30M"},
+		{48 * time.Hour, "P2D"},
+		{49*time.Hour + 3*time.Second, "P2DT1H3S"},
+		{-100 * time.Second, "-PT1M40S"},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.test).ISO8601String(); got != tt.expected {
+			t.Errorf("Parse(%v).ISO8601String() = %q, expected %q", tt.test, got, tt.expected)
+		}
+	}
+}
+
+func TestDurafmt_ClockString(t *testing.T) {
+	tests := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{0, "0:00:00"},
+		{999 * time.Millisecond, "0:00:00"},
+		{61 * time.Second, "0:01:01"},
+		{26*time.Hour + 3*time.Minute, "26:03:00"},
+		{-61 * time.Second, "-0:01:01"},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.test).ClockString(); got != tt.expected {
+			t.Errorf("Parse(%v).ClockString() = %q, expected %q", tt.test, got, tt.expected)
+		}
+	}
+}
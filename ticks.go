@@ -0,0 +1,83 @@
+package durafmt
+
+import "time"
+
+// tickSteps the "nice" steps between ticks of Ticks, shortest first.
+var tickSteps = []time.Duration{
+	time.Microsecond, 2 * time.Microsecond, 5 * time.Microsecond,
+	10 * time.Microsecond, 20 * time.Microsecond, 50 * time.Microsecond,
+	100 * time.Microsecond, 200 * time.Microsecond, 500 * time.Microsecond,
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 200 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 2 * 24 * time.Hour,
+	7 * 24 * time.Hour, 2 * 7 * 24 * time.Hour, 4 * 7 * 24 * time.Hour,
+	365 * 24 * time.Hour, 2 * 365 * 24 * time.Hour, 5 * 365 * 24 * time.Hour,
+	10 * 365 * 24 * time.Hour, 20 * 365 * 24 * time.Hour, 50 * 365 * 24 * time.Hour,
+	100 * 365 * 24 * time.Hour,
+}
+
+// tickFormatter formats the labels of Ticks, "1m30s".
+var tickFormatter = NewFormatter(WithLayout(LayoutInternational), WithSeparators("", ""))
+
+// Tick a tick of a duration axis.
+type Tick struct {
+	Value time.Duration
+	Label string
+}
+
+// Ticks returns evenly spaced ticks covering the range [min, max] of a
+// duration axis, at most n of them, labelled with compact international
+// units, e.g. "0s", "15s", "30s", "45s", "1m".
+// See Formatter.Ticks.
+func Ticks(min, max time.Duration, n int) []Tick {
+	return tickFormatter.Ticks(min, max, n)
+}
+
+// Ticks returns ticks like the package function Ticks, labelled with f.
+//
+// The step between ticks is the shortest "nice" step, 1, 2 or 5 times a power
+// of ten of microseconds or milliseconds, 1, 2, 5, 10, 15 or 30 seconds or
+// minutes, 1, 2, 3, 6 or 12 hours, 1 or 2 days, 1, 2 or 4 weeks, or 1, 2, 5,
+// 10, 20, 50 or 100 years, giving at most n ticks, or 100 years for longer
+// spans. The ticks are multiples of the step, so their labels have no unit
+// shorter than the step, e.g. ticks every 30 seconds are labelled "1m", "1m30s" and "2m".
+// min and max may be given in any order, n < 2 is 2.
+func (f Formatter) Ticks(min, max time.Duration, n int) []Tick {
+	if min > max {
+		min, max = max, min
+	}
+	if n < 2 {
+		n = 2
+	}
+	span := uint64(max) - uint64(min)
+
+	step := tickSteps[len(tickSteps)-1]
+	for _, s := range tickSteps {
+		if span/uint64(s) < uint64(n) {
+			step = s
+			break
+		}
+	}
+
+	// The first multiple of step not less than min.
+	v := min / step * step
+	if v < min {
+		if v > 1<<63-1-step {
+			return nil
+		}
+		v += step
+	}
+	var ticks []Tick
+	for v <= max {
+		ticks = append(ticks, Tick{v, f.Format(v)})
+		if v > max-step {
+			break
+		}
+		v += step
+	}
+	return ticks
+}
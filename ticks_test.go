@@ -0,0 +1,64 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTicks(t *testing.T) {
+	tests := []struct {
+		min, max time.Duration
+		n        int
+		expected []string
+	}{
+		{0, time.Minute, 5, []string{"0s", "15s", "30s", "45s", "1m"}},
+		{0, time.Minute, 7, []string{"0s", "10s", "20s", "30s", "40s", "50s", "1m"}},
+		{time.Minute, 0, 5, []string{"0s", "15s", "30s", "45s", "1m"}},
+		{50 * time.Second, 3 * time.Minute, 6, []string{"1m", "1m30s", "2m", "2m30s", "3m"}},
+		{0, 950 * time.Millisecond, 5, []string{"0s", "200ms", "400ms", "600ms", "800ms"}},
+		{1500 * time.Microsecond, 3 * time.Millisecond, 4, []string{"1ms500µs", "2ms", "2ms500µs", "3ms"}},
+		{0, 10 * time.Hour, 4, []string{"0s", "3h", "6h", "9h"}},
+		{0, 36 * time.Hour, 4, []string{"0s", "12h", "1d", "1d12h"}},
+		{0, 8 * 7 * 24 * time.Hour, 3, []string{"0s", "4w", "8w"}},
+		{-time.Minute, time.Minute, 3, []string{"-1m", "0s", "1m"}},
+		{-25 * time.Second, -5 * time.Second, 3, []string{"-20s", "-10s"}},
+		{5 * time.Second, 5 * time.Second, 5, []string{"5s"}},
+		{1100 * time.Millisecond, 1200 * time.Millisecond, 1, []string{"1s100ms", "1s200ms"}},
+		{1<<63 - 1, 1<<63 - 1, 5, nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, tick := range Ticks(tt.min, tt.max, tt.n) {
+			got = append(got, tick.Label)
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Ticks(%v, %v, %d) = %q, expected %q", tt.min, tt.max, tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestTicks_Values(t *testing.T) {
+	min, max := time.Duration(-1<<63), time.Duration(1<<63-1)
+	ticks := Ticks(min, max, 10)
+	if len(ticks) == 0 {
+		t.Fatalf("Ticks(%v, %v, 10) is empty", min, max)
+	}
+	for i, tick := range ticks {
+		if tick.Value < min || tick.Value > max {
+			t.Errorf("tick %d = %v, out of range", i, tick.Value)
+		}
+		if i > 0 && tick.Value-ticks[i-1].Value != ticks[1].Value-ticks[0].Value {
+			t.Errorf("tick %d = %v, not evenly spaced", i, tick.Value)
+		}
+	}
+}
+
+func TestFormatter_Ticks(t *testing.T) {
+	f := NewFormatter(WithLocale("pt"))
+	expected := []Tick{{0, "0 segundos"}, {time.Hour, "1 hora"}, {2 * time.Hour, "2 horas"}}
+	if got := f.Ticks(0, 2*time.Hour, 3); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Formatter.Ticks() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,54 @@
+package durafmt
+
+import "time"
+
+const (
+	// SiderealDay the rotation period of the Earth relative to the stars, 86164.0905 seconds.
+	SiderealDay = 86164090500 * time.Microsecond
+	// Sol a mean solar day on Mars, 88775.244 seconds.
+	Sol = 88775244 * time.Millisecond
+	// SynodicMonth the mean lunar synodic month, new moon to new moon, 29.530588853 days.
+	SynodicMonth = 2551442876899200 * time.Nanosecond
+)
+
+// earthUnits the units of DefaultUnitSystem from hours to microseconds.
+var earthUnits = DefaultUnitSystem[3:]
+
+// withEarthUnits returns the unit u followed by earthUnits.
+func withEarthUnits(u SystemUnit) UnitSystem {
+	return append(UnitSystem{u}, earthUnits...)
+}
+
+var (
+	// SiderealUnitSystem sidereal days, then hours to microseconds, "3 sidereal days 4 hours".
+	SiderealUnitSystem = withEarthUnits(SystemUnit{Unit{"sidereal day", "sidereal days"}, "sd", SiderealDay})
+	// MarsUnitSystem Martian sols, then hours to microseconds, "3 sols 4 hours".
+	MarsUnitSystem = withEarthUnits(SystemUnit{Unit{"sol", "sols"}, "sol", Sol})
+	// LunarUnitSystem lunar synodic months, then days to microseconds, "2 lunar months 3 days".
+	LunarUnitSystem = append(UnitSystem{
+		{Unit{"lunar month", "lunar months"}, "lm", SynodicMonth},
+		DefaultUnitSystem[2],
+	}, earthUnits...)
+)
+
+// ParseSystem creates a new *Durafmt struct from a human readable string in
+// the units of s, by their singular, plural or short names, e.g.
+// `ParseSystem("3 sols 4 hours", MarsUnitSystem)`, output in the units of s.
+// The syntax is the syntax of ParseHuman.
+// returns an error if input is invalid.
+func ParseSystem(input string, s UnitSystem) (*Durafmt, error) {
+	duration, err := parseNames(input, systemNames(s))
+	if err != nil {
+		return nil, err
+	}
+	return &Durafmt{duration: duration, input: duration.String(), config: config{system: s}}, nil
+}
+
+// Convert returns a copy of d output in the units of s, e.g.
+// `ParseSystem("3 sols", MarsUnitSystem)` converted to DefaultUnitSystem is
+// "3 days 1 hour 58 minutes 45 seconds 732 milliseconds".
+func (d *Durafmt) Convert(s UnitSystem) *Durafmt {
+	c := *d
+	c.system = s
+	return &c
+}
@@ -0,0 +1,97 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeBases(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		system   UnitSystem
+		expected string
+	}{
+		{3*Sol + 4*time.Hour, MarsUnitSystem, "3 sols 4 hours"},
+		{Sol, MarsUnitSystem, "1 sol"},
+		{24 * time.Hour, MarsUnitSystem, "24 hours"},
+		{24 * time.Hour, SiderealUnitSystem, "1 sidereal day 3 minutes 55 seconds 909 milliseconds 500 microseconds"},
+		{365 * 24 * time.Hour, SiderealUnitSystem, "365 sidereal days 23 hours 55 minutes 6 seconds 967 milliseconds 500 microseconds"},
+		{SynodicMonth, DefaultUnitSystem, "4 weeks 1 day 12 hours 44 minutes 2 seconds 876 milliseconds 899 microseconds"},
+		{365 * 24 * time.Hour, LunarUnitSystem, "12 lunar months 10 days 15 hours 11 minutes 25 seconds 477 milliseconds 209 microseconds"},
+		{-2 * SynodicMonth, LunarUnitSystem, "-2 lunar months"},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.d).FormatSystem(tt.system); got != tt.expected {
+			t.Errorf("Parse(%v).FormatSystem() = %q, expected %q", tt.d, got, tt.expected)
+		}
+	}
+
+	if got := New(3*Sol+4*time.Hour, WithUnitSystem(MarsUnitSystem), WithLayout(LayoutInternational)).String(); got != "3 sol 4 h" {
+		t.Errorf("Mars InternationalString = %q", got)
+	}
+	if got := New(100*24*time.Hour, WithUnitSystem(MarsUnitSystem), WithDecimals(2)).String(); got != "97.32 sols" {
+		t.Errorf("Mars decimals = %q", got)
+	}
+}
+
+func TestParseSystem(t *testing.T) {
+	tests := []struct {
+		input    string
+		system   UnitSystem
+		expected time.Duration
+		output   string
+	}{
+		{"3 sols 4 hours", MarsUnitSystem, 3*Sol + 4*time.Hour, "3 sols 4 hours"},
+		{"1 sol, 30 minutes", MarsUnitSystem, Sol + 30*time.Minute, "1 sol 30 minutes"},
+		{"2.5 sol", MarsUnitSystem, 2*Sol + Sol/2, "2 sols 12 hours 19 minutes 47 seconds 622 milliseconds"},
+		{"-1 sidereal day", SiderealUnitSystem, -SiderealDay, "-1 sidereal day"},
+		{"2 sd 3 h", SiderealUnitSystem, 2*SiderealDay + 3*time.Hour, "2 sidereal days 3 hours"},
+		{"1 lunar month and 2 days", LunarUnitSystem, SynodicMonth + 48*time.Hour, "1 lunar month 2 days"},
+	}
+	for _, tt := range tests {
+		d, err := ParseSystem(tt.input, tt.system)
+		if err != nil {
+			t.Errorf("ParseSystem(%q) error: %v", tt.input, err)
+			continue
+		}
+		if d.Duration() != tt.expected {
+			t.Errorf("ParseSystem(%q).Duration() = %v, expected %v", tt.input, d.Duration(), tt.expected)
+		}
+		if got := d.String(); got != tt.output {
+			t.Errorf("ParseSystem(%q).String() = %q, expected %q", tt.input, got, tt.output)
+		}
+	}
+
+	for _, input := range []string{"", "3 days", "3 weeks", "sols", "3 sols 4"} {
+		if _, err := ParseSystem(input, MarsUnitSystem); err == nil {
+			t.Errorf("ParseSystem(%q) expected an error", input)
+		}
+	}
+}
+
+func TestDurafmt_Convert(t *testing.T) {
+	d, err := ParseSystem("3 sols", MarsUnitSystem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		system   UnitSystem
+		expected string
+	}{
+		{DefaultUnitSystem, "3 days 1 hour 58 minutes 45 seconds 732 milliseconds"},
+		{SiderealUnitSystem, "3 sidereal days 2 hours 10 minutes 33 seconds 460 milliseconds 500 microseconds"},
+		{MarsUnitSystem, "3 sols"},
+	}
+	for _, tt := range tests {
+		if got := d.Convert(tt.system).String(); got != tt.expected {
+			t.Errorf("Convert() = %q, expected %q", got, tt.expected)
+		}
+	}
+	if got := d.Convert(DefaultUnitSystem).LimitToUnit("hours").LimitFirstN(2).String(); got != "73 hours 58 minutes" {
+		t.Errorf("Convert().LimitToUnit(\"hours\") = %q", got)
+	}
+	// d is unchanged.
+	if got := d.String(); got != "3 sols" {
+		t.Errorf("String() after Convert() = %q", got)
+	}
+}